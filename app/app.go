@@ -10,14 +10,13 @@ import (
 	"github.com/terra-project/core/update"
 	"github.com/terra-project/core/version"
 	"github.com/terra-project/core/x/budget"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
 	"github.com/terra-project/core/x/pay"
 	"github.com/terra-project/core/x/treasury"
 
-	"github.com/terra-project/core/types/assets"
-
 	tdistr "github.com/terra-project/core/x/distribution"
 	tslashing "github.com/terra-project/core/x/slashing"
 	tstaking "github.com/terra-project/core/x/staking"
@@ -77,6 +76,7 @@ type TerraApp struct {
 	keyMarket        *sdk.KVStoreKey
 	keyBudget        *sdk.KVStoreKey
 	keyMint          *sdk.KVStoreKey
+	keyCalendar      *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountKeeper       auth.AccountKeeper
@@ -92,6 +92,7 @@ type TerraApp struct {
 	marketKeeper        market.Keeper
 	budgetKeeper        budget.Keeper
 	mintKeeper          mint.Keeper
+	calendarKeeper      calendar.Keeper
 }
 
 // NewTerraApp returns a reference to an initialized TerraApp.
@@ -120,6 +121,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		keyMarket:               sdk.NewKVStoreKey(market.StoreKey),
 		keyBudget:               sdk.NewKVStoreKey(budget.StoreKey),
 		keyMint:                 sdk.NewKVStoreKey(mint.StoreKey),
+		keyCalendar:             sdk.NewKVStoreKey(calendar.StoreKey),
 	}
 
 	app.paramsKeeper = params.NewKeeper(
@@ -169,12 +171,19 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		app.bankKeeper,
 		app.feeCollectionKeeper,
 	)
+	app.calendarKeeper = calendar.NewKeeper(
+		app.cdc,
+		app.keyCalendar,
+		app.paramsKeeper.Subspace(calendar.DefaultParamspace),
+	)
 	app.mintKeeper = mint.NewKeeper(
 		app.cdc,
 		app.keyMint,
 		stakingKeeper,
 		app.bankKeeper,
 		app.accountKeeper,
+		app.calendarKeeper,
+		app.paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 	app.oracleKeeper = oracle.NewKeeper(
 		app.cdc,
@@ -190,6 +199,9 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		app.keyMarket,
 		app.oracleKeeper,
 		app.mintKeeper,
+		app.bankKeeper,
+		app.distrKeeper,
+		app.calendarKeeper,
 		app.paramsKeeper.Subspace(market.DefaultParamspace),
 	)
 	app.treasuryKeeper = treasury.NewKeeper(
@@ -198,15 +210,21 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		stakingKeeper.GetValidatorSet(),
 		app.mintKeeper,
 		app.marketKeeper,
+		app.oracleKeeper,
+		app.distrKeeper,
+		app.calendarKeeper,
 		app.paramsKeeper.Subspace(treasury.DefaultParamspace),
 	)
 	app.budgetKeeper = budget.NewKeeper(
 		app.cdc,
 		app.keyBudget,
 		app.marketKeeper,
+		app.oracleKeeper,
 		app.mintKeeper,
 		app.treasuryKeeper,
 		stakingKeeper.GetValidatorSet(),
+		stakingKeeper,
+		app.calendarKeeper,
 		app.paramsKeeper.Subspace(budget.DefaultParamspace),
 	)
 
@@ -220,15 +238,19 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 	bank.RegisterInvariants(&app.crisisKeeper, app.accountKeeper)
 	distr.RegisterInvariants(&app.crisisKeeper, app.distrKeeper, app.stakingKeeper)
 	staking.RegisterInvariants(&app.crisisKeeper, app.stakingKeeper, app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper)
+	mint.RegisterInvariants(&app.crisisKeeper, app.mintKeeper, app.feeCollectionKeeper, app.distrKeeper, app.oracleKeeper)
+	oracle.RegisterInvariants(&app.crisisKeeper, app.oracleKeeper)
+	treasury.RegisterInvariants(&app.crisisKeeper, app.treasuryKeeper)
 
 	// register message routes
 	app.Router().
-		AddRoute(bank.RouterKey, pay.NewHandler(app.bankKeeper, app.treasuryKeeper, app.feeCollectionKeeper)).
+		AddRoute(bank.RouterKey, pay.NewHandler(app.bankKeeper, app.treasuryKeeper)).
 		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
 		AddRoute(distr.RouterKey, distr.NewHandler(app.distrKeeper)).
 		AddRoute(slashing.RouterKey, slashing.NewHandler(app.slashingKeeper)).
 		AddRoute(oracle.RouterKey, oracle.NewHandler(app.oracleKeeper)).
 		AddRoute(budget.RouterKey, budget.NewHandler(app.budgetKeeper)).
+		AddRoute(treasury.RouterKey, treasury.NewHandler(app.treasuryKeeper)).
 		AddRoute(market.RouterKey, market.NewHandler(app.marketKeeper)).
 		AddRoute(crisis.RouterKey, crisis.NewHandler(app.crisisKeeper))
 
@@ -239,6 +261,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc)).
 		AddRoute(treasury.QuerierRoute, treasury.NewQuerier(app.treasuryKeeper)).
 		AddRoute(market.QuerierRoute, market.NewQuerier(app.marketKeeper)).
+		AddRoute(mint.QuerierRoute, mint.NewQuerier(app.mintKeeper)).
 		AddRoute(oracle.QuerierRoute, oracle.NewQuerier(app.oracleKeeper)).
 		AddRoute(budget.QuerierRoute, budget.NewQuerier(app.budgetKeeper))
 
@@ -248,10 +271,11 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest,
 		app.keySlashing, app.keyFeeCollection, app.keyParams,
 		app.tkeyParams, app.tkeyStaking, app.tkeyDistr, app.keyMarket,
 		app.keyOracle, app.keyTreasury, app.keyBudget, app.keyMint,
+		app.keyCalendar,
 	)
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	app.SetAnteHandler(pay.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper, app.treasuryKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -308,6 +332,12 @@ func MakeCodec() *codec.Codec {
 // BeginBlocker application updates every end block
 func (app *TerraApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 
+	// migrate stores of chains started with an older version
+	updateTags := update.BeginBlocker(ctx, app.mintKeeper, app.feeCollectionKeeper, app.distrKeeper, app.oracleKeeper)
+
+	// close the day before any module reads it
+	calendar.BeginBlocker(ctx, app.calendarKeeper)
+
 	// distribute rewards for the previous block
 	distr.BeginBlocker(ctx, req, app.distrKeeper)
 
@@ -317,6 +347,7 @@ func (app *TerraApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) a
 	// so as to keep the CanWithdrawInvariant invariant.
 	// TODO: This should really happen at EndBlocker.
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
+	tags = append(tags, updateTags...)
 
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
@@ -330,12 +361,19 @@ func (app *TerraApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 	oracleTags := oracle.EndBlocker(ctx, app.oracleKeeper)
 	tags = append(tags, oracleTags...)
 
-	budgetTags := budget.EndBlocker(ctx, app.budgetKeeper)
-	tags = append(tags, budgetTags...)
+	marketTags := market.EndBlocker(ctx, app.marketKeeper)
+	tags = append(tags, marketTags...)
 
+	// The treasury allocates the seigniorage of the epoch before budget programs are paid from it
 	treasuryTags := treasury.EndBlocker(ctx, app.treasuryKeeper)
 	tags = append(tags, treasuryTags...)
 
+	budgetTags := budget.EndBlocker(ctx, app.budgetKeeper)
+	tags = append(tags, budgetTags...)
+
+	// Prune issuance history after the treasury has read it for the epoch
+	mint.EndBlocker(ctx, app.mintKeeper)
+
 	updateTags := update.EndBlocker(ctx, app.accountKeeper, app.oracleKeeper, app.marketKeeper)
 	tags = append(tags, updateTags...)
 
@@ -374,6 +412,8 @@ func (app *TerraApp) initFromGenesisState(ctx sdk.Context, genesisState GenesisS
 	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
 	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData, genesisState.StakingData.Validators.ToSDKValidators())
 	crisis.InitGenesis(ctx, app.crisisKeeper, genesisState.CrisisData)
+	calendar.InitGenesis(ctx, app.calendarKeeper, genesisState.CalendarData)
+	mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
 	treasury.InitGenesis(ctx, app.treasuryKeeper, genesisState.TreasuryData)
 	market.InitGenesis(ctx, app.marketKeeper, genesisState.MarketData)
 	budget.InitGenesis(ctx, app.budgetKeeper, genesisState.BudgetData)
@@ -433,9 +473,6 @@ func (app *TerraApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abc
 		}
 	}
 
-	// GetIssuance needs to be called once to read account balances to the store
-	app.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.ZeroInt())
-
 	// assert runtime invariants
 	app.assertRuntimeInvariants()
 
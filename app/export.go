@@ -5,7 +5,9 @@ import (
 	"log"
 
 	"github.com/terra-project/core/x/budget"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
 	"github.com/terra-project/core/x/treasury"
 
@@ -53,6 +55,8 @@ func (app *TerraApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteLi
 		treasury.ExportGenesis(ctx, app.treasuryKeeper),
 		slashing.ExportGenesis(ctx, app.slashingKeeper),
 		market.ExportGenesis(ctx, app.marketKeeper),
+		mint.ExportGenesis(ctx, app.mintKeeper),
+		calendar.ExportGenesis(ctx, app.calendarKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
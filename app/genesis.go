@@ -14,7 +14,9 @@ import (
 	"github.com/terra-project/core/types"
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/x/budget"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
 	"github.com/terra-project/core/x/treasury"
 
@@ -43,6 +45,8 @@ type GenesisState struct {
 	CrisisData   crisis.GenesisState   `json:"crisis"`
 	SlashingData slashing.GenesisState `json:"slashing"`
 	MarketData   market.GenesisState   `json:"market"`
+	MintData     mint.GenesisState     `json:"mint"`
+	CalendarData calendar.GenesisState `json:"calendar"`
 	GenTxs       []json.RawMessage     `json:"gentxs"`
 }
 
@@ -57,7 +61,9 @@ func NewGenesisState(accounts []GenesisAccount,
 	crisisData crisis.GenesisState,
 	treasuryData treasury.GenesisState,
 	slashingData slashing.GenesisState,
-	marketData market.GenesisState) GenesisState {
+	marketData market.GenesisState,
+	mintData mint.GenesisState,
+	calendarData calendar.GenesisState) GenesisState {
 
 	return GenesisState{
 		Accounts:     accounts,
@@ -71,6 +77,8 @@ func NewGenesisState(accounts []GenesisAccount,
 		BudgetData:   budgetData,
 		SlashingData: slashingData,
 		MarketData:   marketData,
+		MintData:     mintData,
+		CalendarData: calendarData,
 	}
 }
 
@@ -257,6 +265,8 @@ func NewDefaultGenesisState() GenesisState {
 		CrisisData:   crisis.DefaultGenesisState(),
 		SlashingData: slashing.DefaultGenesisState(),
 		MarketData:   market.DefaultGenesisState(),
+		MintData:     mint.DefaultGenesisState(),
+		CalendarData: calendar.DefaultGenesisState(),
 		GenTxs:       nil,
 	}
 }
@@ -294,6 +304,18 @@ func TerraValidateGenesisState(genesisState GenesisState) error {
 		return err
 	}
 
+	if err := mint.ValidateGenesis(genesisState.MintData); err != nil {
+		return err
+	}
+
+	if err := calendar.ValidateGenesis(genesisState.CalendarData); err != nil {
+		return err
+	}
+
+	if err := mint.ValidateGenesisCalendar(genesisState.MintData, genesisState.CalendarData.Params.DaysPerEpoch()); err != nil {
+		return err
+	}
+
 	return market.ValidateGenesis(genesisState.MarketData)
 }
 
@@ -0,0 +1,178 @@
+package init
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/terra-project/core/x/treasury"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagTreasuryParams = "params"
+	flagTaxRate        = "tax-rate"
+	flagRewardWeight   = "reward-weight"
+)
+
+// CSV columns of the epoch series read by the treasury simulator
+const (
+	columnTaxProceeds = "tax_proceeds"
+	columnSeigniorage = "seigniorage"
+	columnBondedLuna  = "bonded_luna"
+	columnLunaPrice   = "luna_price"
+)
+
+// SimulateTreasuryCmd returns a command that replays a series of epochs through the treasury
+// monetary policy and prints the resulting tax rate and reward weight of every epoch.
+func SimulateTreasuryCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command { // nolint: golint
+	cmd := &cobra.Command{
+		Use:   "simulate-treasury [series-file]",
+		Short: "Simulate the treasury tax rate and reward weight over a series of epochs",
+		Long: strings.TrimSpace(`
+Replays a series of per-epoch tax proceeds, seigniorage and bonded Luna through the treasury
+policy updates, and prints the tax rate and reward weight in effect during every epoch as CSV.
+
+The series is a CSV file with a header row naming the columns tax_proceeds (uSDR),
+seigniorage (uLuna), bonded_luna (uLuna) and optionally luna_price (SDR per Luna, 1 if
+omitted), or a JSON array of objects with the same fields:
+
+$ terrad simulate-treasury history.csv --params treasury-params.json --tax-rate 0.001 --reward-weight 0.05
+
+The params file holds treasury params as printed by 'terracli query treasury params'; the
+default treasury params are used if none is given.
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) (err error) {
+			params := treasury.DefaultParams()
+			if paramsFile := viper.GetString(flagTreasuryParams); paramsFile != "" {
+				bz, err := ioutil.ReadFile(paramsFile)
+				if err != nil {
+					return err
+				}
+
+				if err = cdc.UnmarshalJSON(bz, &params); err != nil {
+					return fmt.Errorf("Error unmarshaling treasury params %s: %s", paramsFile, err.Error())
+				}
+			}
+
+			taxRate, err := sdk.NewDecFromStr(viper.GetString(flagTaxRate))
+			if err != nil {
+				return fmt.Errorf("Invalid tax rate: %s", err.Error())
+			}
+
+			rewardWeight, err := sdk.NewDecFromStr(viper.GetString(flagRewardWeight))
+			if err != nil {
+				return fmt.Errorf("Invalid reward weight: %s", err.Error())
+			}
+
+			epochs, err := loadSimulationEpochs(cdc, args[0])
+			if err != nil {
+				return fmt.Errorf("Error loading epoch series from %s: %s", args[0], err.Error())
+			}
+
+			policies, err := treasury.SimulatePolicy(params, taxRate, rewardWeight, epochs)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(policies.String())
+			return nil
+		},
+	}
+
+	defaultGenesis := treasury.DefaultGenesisState()
+	cmd.Flags().String(flagTreasuryParams, "", "JSON file of the treasury params to simulate; defaults to the default treasury params")
+	cmd.Flags().String(flagTaxRate, defaultGenesis.GenesisTaxRate.String(), "tax rate in effect at the first epoch of the series")
+	cmd.Flags().String(flagRewardWeight, defaultGenesis.GenesisRewardWeight.String(), "reward weight in effect at the first epoch of the series")
+	return cmd
+}
+
+// loadSimulationEpochs reads the epoch series of the treasury simulator from a CSV or JSON file,
+// depending on the file extension
+func loadSimulationEpochs(cdc *codec.Codec, file string) (epochs []treasury.SimulationEpoch, err error) {
+	if strings.ToLower(filepath.Ext(file)) == ".json" {
+		bz, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = cdc.UnmarshalJSON(bz, &epochs); err != nil {
+			return nil, err
+		}
+
+		// Amounts are required and luna price defaults to 1 SDR, as in the CSV format
+		for i := range epochs {
+			if epochs[i].TaxProceeds == (sdk.Int{}) || epochs[i].Seigniorage == (sdk.Int{}) || epochs[i].BondedLuna == (sdk.Int{}) {
+				return nil, fmt.Errorf("epoch %d: tax_proceeds, seigniorage and bonded_luna are required", i)
+			}
+
+			if epochs[i].LunaPrice.Int == nil {
+				epochs[i].LunaPrice = sdk.OneDec()
+			}
+		}
+
+		return epochs, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // nolint: errcheck
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range []string{columnTaxProceeds, columnSeigniorage, columnBondedLuna} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column %s", name)
+		}
+	}
+
+	for row, record := range records[1:] {
+		epoch := treasury.NewSimulationEpoch(sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.OneDec())
+
+		for name, amount := range map[string]*sdk.Int{
+			columnTaxProceeds: &epoch.TaxProceeds,
+			columnSeigniorage: &epoch.Seigniorage,
+			columnBondedLuna:  &epoch.BondedLuna,
+		} {
+			value, ok := sdk.NewIntFromString(strings.TrimSpace(record[columns[name]]))
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid %s %q", row+2, name, record[columns[name]])
+			}
+			*amount = value
+		}
+
+		if i, ok := columns[columnLunaPrice]; ok {
+			if epoch.LunaPrice, err = sdk.NewDecFromStr(strings.TrimSpace(record[i])); err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s %q", row+2, columnLunaPrice, record[i])
+			}
+		}
+
+		epochs = append(epochs, epoch)
+	}
+
+	return epochs, nil
+}
@@ -0,0 +1,69 @@
+package init
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSimulationEpochs(t *testing.T) {
+	t.Parallel()
+	dir, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+
+	// CSV, with and without luna price
+	fname := filepath.Join(dir, "series.csv")
+	require.NoError(t, ioutil.WriteFile(fname, []byte("tax_proceeds,seigniorage,bonded_luna,luna_price\n100,200,300,0.5\n400,500,600,2\n"), 0644))
+
+	epochs, err := loadSimulationEpochs(codec.Cdc, fname)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(epochs))
+	require.Equal(t, sdk.NewInt(100), epochs[0].TaxProceeds)
+	require.Equal(t, sdk.NewInt(200), epochs[0].Seigniorage)
+	require.Equal(t, sdk.NewInt(300), epochs[0].BondedLuna)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), epochs[0].LunaPrice)
+	require.Equal(t, sdk.NewDec(2), epochs[1].LunaPrice)
+
+	require.NoError(t, ioutil.WriteFile(fname, []byte("bonded_luna,seigniorage,tax_proceeds\n300,200,100\n"), 0644))
+	epochs, err = loadSimulationEpochs(codec.Cdc, fname)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(epochs))
+	require.Equal(t, sdk.NewInt(100), epochs[0].TaxProceeds)
+	require.Equal(t, sdk.NewInt(300), epochs[0].BondedLuna)
+	require.Equal(t, sdk.OneDec(), epochs[0].LunaPrice)
+
+	// Missing column
+	require.NoError(t, ioutil.WriteFile(fname, []byte("tax_proceeds,seigniorage\n100,200\n"), 0644))
+	_, err = loadSimulationEpochs(codec.Cdc, fname)
+	require.Error(t, err)
+
+	// Invalid amount
+	require.NoError(t, ioutil.WriteFile(fname, []byte("tax_proceeds,seigniorage,bonded_luna\n100,abc,300\n"), 0644))
+	_, err = loadSimulationEpochs(codec.Cdc, fname)
+	require.Error(t, err)
+
+	// JSON
+	fname = filepath.Join(dir, "series.json")
+	require.NoError(t, ioutil.WriteFile(fname, []byte(`[{"tax_proceeds":"100","seigniorage":"200","bonded_luna":"300"}]`), 0644))
+
+	epochs, err = loadSimulationEpochs(codec.Cdc, fname)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(epochs))
+	require.Equal(t, sdk.NewInt(200), epochs[0].Seigniorage)
+	require.Equal(t, sdk.OneDec(), epochs[0].LunaPrice)
+
+	// Missing amount
+	require.NoError(t, ioutil.WriteFile(fname, []byte(`[{"tax_proceeds":"100","seigniorage":"200"}]`), 0644))
+	_, err = loadSimulationEpochs(codec.Cdc, fname)
+	require.Error(t, err)
+
+	// Non-existing file
+	_, err = loadSimulationEpochs(codec.Cdc, filepath.Join(dir, "non-existing-file.csv"))
+	require.Error(t, err)
+}
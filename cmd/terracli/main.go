@@ -34,12 +34,14 @@ import (
 
 	budget "github.com/terra-project/core/x/budget/client/rest"
 	market "github.com/terra-project/core/x/market/client/rest"
+	mint "github.com/terra-project/core/x/mint/client/rest"
 	oracle "github.com/terra-project/core/x/oracle/client/rest"
 	pay "github.com/terra-project/core/x/pay/client/rest"
 	treasury "github.com/terra-project/core/x/treasury/client/rest"
 
 	bud "github.com/terra-project/core/x/budget"
 	mkt "github.com/terra-project/core/x/market"
+	mnt "github.com/terra-project/core/x/mint"
 	ora "github.com/terra-project/core/x/oracle"
 	tre "github.com/terra-project/core/x/treasury"
 
@@ -55,6 +57,7 @@ import (
 	budgetClient "github.com/terra-project/core/x/budget/client"
 	distClient "github.com/terra-project/core/x/distribution/client"
 	marketClient "github.com/terra-project/core/x/market/client"
+	mintClient "github.com/terra-project/core/x/mint/client"
 	oracleClient "github.com/terra-project/core/x/oracle/client"
 	slashingClient "github.com/terra-project/core/x/slashing/client"
 	stakingClient "github.com/terra-project/core/x/staking/client"
@@ -95,6 +98,7 @@ func main() {
 		treasuryClient.NewModuleClient(tre.StoreKey, cdc),
 		budgetClient.NewModuleClient(bud.StoreKey, cdc),
 		marketClient.NewModuleClient(mkt.StoreKey, cdc),
+		mintClient.NewModuleClient(mnt.StoreKey, cdc),
 		crisisClient.NewModuleClient(sl.StoreKey, cdc),
 	}
 
@@ -209,6 +213,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	oracle.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	treasury.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	market.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
+	mint.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 	budget.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc)
 }
 
@@ -56,6 +56,7 @@ func main() {
 	rootCmd.AddCommand(terraInit.GenTxCmd(ctx, cdc))
 	rootCmd.AddCommand(terraInit.AddGenesisAccountCmd(ctx, cdc))
 	rootCmd.AddCommand(terraInit.ValidateGenesisCmd(ctx, cdc))
+	rootCmd.AddCommand(terraInit.SimulateTreasuryCmd(ctx, cdc))
 	rootCmd.AddCommand(client.NewCompletionCmd(rootCmd, true))
 
 	// preempting version command
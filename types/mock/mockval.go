@@ -63,3 +63,62 @@ func (mv MockValset) IterateBondedValidatorsByPower(ctx sdk.Context,
 		}
 	}
 }
+
+func (mv MockValidator) GetDelegatorShares() sdk.Dec {
+	return sdk.NewDecFromInt(mv.Power)
+}
+
+type MockDelegation struct {
+	sdk.Delegation
+
+	Delegator sdk.AccAddress
+	Validator sdk.ValAddress
+	Shares    sdk.Dec
+}
+
+func NewMockDelegation(delegator sdk.AccAddress, validator sdk.ValAddress, shares sdk.Dec) MockDelegation {
+	return MockDelegation{
+		Delegator: delegator,
+		Validator: validator,
+		Shares:    shares,
+	}
+}
+
+func (md MockDelegation) GetDelegatorAddr() sdk.AccAddress {
+	return md.Delegator
+}
+
+func (md MockDelegation) GetValidatorAddr() sdk.ValAddress {
+	return md.Validator
+}
+
+func (md MockDelegation) GetShares() sdk.Dec {
+	return md.Shares
+}
+
+type MockDelegationSet struct {
+	sdk.DelegationSet
+
+	Delegations []MockDelegation
+}
+
+func NewMockDelegationSet() MockDelegationSet {
+	return MockDelegationSet{
+		Delegations: []MockDelegation{},
+	}
+}
+
+func (md MockDelegationSet) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+	fn func(index int64, delegation sdk.Delegation) (stop bool)) {
+	var i int64
+	for _, delegation := range md.Delegations {
+		if !delegation.Delegator.Equals(delegator) {
+			continue
+		}
+
+		if fn(i, delegation) {
+			break
+		}
+		i++
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default period lengths; the day and epoch lengths in effect are calendar params
 // nolint
 const (
 	BlocksPerMinute = int64(10)
@@ -16,12 +17,6 @@ const (
 	BlocksPerEpoch = BlocksPerWeek
 )
 
-// GetEpoch returns the current epoch, starting from 0
-func GetEpoch(ctx sdk.Context) sdk.Int {
-	curEpoch := ctx.BlockHeight() / BlocksPerEpoch
-	return sdk.NewInt(curEpoch)
-}
-
 // IsPeriodLastBlock returns true if we are at the last block of the period
 func IsPeriodLastBlock(ctx sdk.Context, blocksPerPeriod int64) bool {
 	return (ctx.BlockHeight()+1)%blocksPerPeriod == 0
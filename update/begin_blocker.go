@@ -0,0 +1,23 @@
+package update
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/update/plan"
+	"github.com/terra-project/core/x/mint"
+)
+
+// BeginBlocker runs store migrations before any transaction of the block reads the migrated state
+func BeginBlocker(
+	ctx sdk.Context,
+	mintKeeper mint.Keeper,
+	feeCollectionKeeper mint.FeeCollectionKeeper,
+	distrKeeper mint.DistributionKeeper,
+	oracleKeeper mint.OracleKeeper) (resTags sdk.Tags) {
+
+	if plan.MigrateSupply(ctx, mintKeeper, feeCollectionKeeper, distrKeeper, oracleKeeper) {
+		resTags = resTags.AppendTag(plan.TagMigrateSupply, "migrated")
+	}
+
+	return
+}
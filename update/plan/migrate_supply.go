@@ -0,0 +1,25 @@
+package plan
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/mint"
+)
+
+var (
+	// TagMigrateSupply is tag key for the mint supply record migration
+	TagMigrateSupply = "migrate_supply"
+)
+
+// MigrateSupply initializes the mint supply records on chains that started before they existed
+func MigrateSupply(ctx sdk.Context, mintKeeper mint.Keeper,
+	feeCollectionKeeper mint.FeeCollectionKeeper, distrKeeper mint.DistributionKeeper, oracleKeeper mint.OracleKeeper) bool {
+
+	// chains started with supply records, or already migrated
+	if mintKeeper.IsSupplyInitialized(ctx) {
+		return false
+	}
+
+	mintKeeper.MigrateSupply(ctx, feeCollectionKeeper, distrKeeper, oracleKeeper)
+	return true
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/terra-project/core/types"
 	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
@@ -71,6 +72,7 @@ func createTestInput(t *testing.T) testInput {
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracle.StoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(market.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewKVStoreKey(staking.TStoreKey)
@@ -88,6 +90,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
@@ -128,12 +131,17 @@ func createTestInput(t *testing.T) testInput {
 		bankKeeper, &stakingKeeper, feeCollectionKeeper, distr.DefaultCodespace,
 	)
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := mint.NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	stakingKeeper.SetPool(ctx, staking.InitialPool())
@@ -156,6 +164,9 @@ func createTestInput(t *testing.T) testInput {
 		keyMarket,
 		oracleKeeper,
 		mintKeeper,
+		bankKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(market.DefaultParamspace),
 	)
 
@@ -3,6 +3,7 @@ package bench
 import (
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/x/budget"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
@@ -71,6 +72,7 @@ func createTestInput() testInput {
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyMarket := sdk.NewKVStoreKey(market.StoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyFee := sdk.NewKVStoreKey(auth.FeeStoreKey)
 	keyBudget := sdk.NewKVStoreKey(budget.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracle.StoreKey)
@@ -90,6 +92,7 @@ func createTestInput() testInput {
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyBudget, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
@@ -138,12 +141,17 @@ func createTestInput() testInput {
 		bankKeeper, stakingKeeper, feeKeeper, distr.DefaultCodespace,
 	)
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := mint.NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	sh := staking.NewHandler(stakingKeeper)
@@ -187,6 +195,9 @@ func createTestInput() testInput {
 		keyMarket,
 		oracleKeeper,
 		mintKeeper,
+		bankKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(market.DefaultParamspace))
 
 	treasuryKeeper := treasury.NewKeeper(
@@ -195,11 +206,14 @@ func createTestInput() testInput {
 		stakingKeeper.GetValidatorSet(),
 		mintKeeper,
 		marketKeeper,
+		oracleKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(treasury.DefaultParamspace),
 	)
 
 	budgetKeeper := budget.NewKeeper(
-		cdc, keyBudget, marketKeeper, mintKeeper, treasuryKeeper, stakingKeeper.GetValidatorSet(),
+		cdc, keyBudget, marketKeeper, oracleKeeper, mintKeeper, treasuryKeeper, stakingKeeper.GetValidatorSet(), stakingKeeper, calendarKeeper,
 		paramsKeeper.Subspace(budget.DefaultParamspace),
 	)
 
@@ -6,12 +6,14 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/x/budget"
 )
 
 func BenchmarkSubmitAndVoteProgramsPerBlock(b *testing.B) {
 	const numOfPrograms = 5
 	input := createTestInput()
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
 
 	defaultBudgetParams := budget.DefaultParams()
 	defaultBudgetParams.VotePeriod = 1
@@ -25,7 +27,8 @@ func BenchmarkSubmitAndVoteProgramsPerBlock(b *testing.B) {
 
 		for p := 0; p < numOfPrograms; p++ {
 			// registers programs
-			msg := budget.NewMsgSubmitProgram(fmt.Sprintf("test-%d-%d", i, p), "description", addrs[p], addrs[p+1])
+			msg := budget.NewMsgSubmitProgram(fmt.Sprintf("test-%d-%d", i, p), "description", addrs[p], addrs[p+1],
+				sdk.NewInt64Coin(assets.MicroSDRDenom, 1000), sdk.NewInt(100), nil)
 			res := h(ctx, msg)
 
 			if !res.IsOK() {
@@ -34,7 +37,8 @@ func BenchmarkSubmitAndVoteProgramsPerBlock(b *testing.B) {
 
 			for v := 0; v < numOfValidators; v++ {
 				// votes to registered program
-				voteMsg := budget.NewMsgVoteProgram(uint64(i*numOfPrograms+p+1), rand.Intn(2) == 0, addrs[v])
+				option := []budget.VoteOption{budget.OptionYes, budget.OptionAbstain, budget.OptionNo, budget.OptionNoWithVeto}[rand.Intn(4)]
+				voteMsg := budget.NewMsgVoteProgram(uint64(i*numOfPrograms+p+1), option, addrs[v])
 				res := h(ctx, voteMsg)
 
 				if !res.IsOK() {
@@ -50,6 +54,7 @@ func BenchmarkSubmitAndVoteProgramsPerBlock(b *testing.B) {
 
 func BenchmarkSubmitAndWithdrawProgramPerBlock(b *testing.B) {
 	input := createTestInput()
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
 
 	h := budget.NewHandler(input.budgetKeeper)
 
@@ -61,7 +66,8 @@ func BenchmarkSubmitAndWithdrawProgramPerBlock(b *testing.B) {
 			var msg sdk.Msg
 
 			if i%2 == 0 {
-				msg = budget.NewMsgSubmitProgram(fmt.Sprintf("test-%d-%d", i, v), "description", addrs[v], addrs[v])
+				msg = budget.NewMsgSubmitProgram(fmt.Sprintf("test-%d-%d", i, v), "description", addrs[v], addrs[v],
+					sdk.NewInt64Coin(assets.MicroSDRDenom, 1000), sdk.NewInt(100), nil)
 			} else {
 				msg = budget.NewMsgWithdrawProgram(uint64((i/2)*numOfValidators+v+1), addrs[v])
 			}
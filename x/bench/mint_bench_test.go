@@ -0,0 +1,60 @@
+package bench
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BenchmarkMintBurnPerBlock(b *testing.B) {
+	input := createTestInput()
+
+	coin := sdk.NewCoin(assets.MicroSDRDenom, uSDRAmt)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx := input.ctx.WithBlockHeight(int64(i))
+
+		for j := 0; j < numOfValidators; j++ {
+			if err := input.mintKeeper.Mint(ctx, addrs[j], coin); err != nil {
+				panic(err)
+			}
+
+			if err := input.mintKeeper.Burn(ctx, addrs[j], coin); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+func BenchmarkMintGetPastIssuance(b *testing.B) {
+	input := createTestInput()
+
+	// One mint per day, for a year
+	days := int64(365)
+	for day := int64(0); day < days; day++ {
+		ctx := input.ctx.WithBlockHeight(day * util.BlocksPerDay)
+		if err := input.mintKeeper.Mint(ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, uLunaAmt)); err != nil {
+			panic(err)
+		}
+	}
+
+	ctx := input.ctx.WithBlockHeight(days * util.BlocksPerDay)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(int64(i)%days))
+	}
+}
+
+func BenchmarkMintTotalSupply(b *testing.B) {
+	input := createTestInput()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		input.mintKeeper.GetTotalSupply(input.ctx)
+	}
+}
@@ -37,6 +37,8 @@ func TestSubmitProgramTx(t *testing.T) {
 		`--title=testprogram`,
 		`--description=testprogramtestprogram`,
 		`--executor=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--requested-budget=1000usdr`,
+		`--epoch-ceiling=100`,
 		`--generate-only`,
 		`--offline`,
 		`--chain-id=columbus`,
@@ -106,6 +108,68 @@ func TestWithdrawProgramTx(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestClaimMilestoneTx(t *testing.T) {
+	cdc, rootCmd, txCmd, _ := testutil.PrepareCmdTest()
+
+	budgetTxCmd := &cobra.Command{
+		Use:   "budget",
+		Short: "budget transaction subcommands",
+	}
+
+	txCmd.AddCommand(budgetTxCmd)
+
+	budgetTxCmd.AddCommand(client.PostCommands(
+		GetCmdClaimMilestone(cdc),
+	)...)
+
+	// normal case all parameter given
+	_, err := testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`budget`,
+		`claim-milestone`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--program-id=1`,
+		`--milestone=0`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+}
+
+func TestApproveMilestoneTx(t *testing.T) {
+	cdc, rootCmd, txCmd, _ := testutil.PrepareCmdTest()
+
+	budgetTxCmd := &cobra.Command{
+		Use:   "budget",
+		Short: "budget transaction subcommands",
+	}
+
+	txCmd.AddCommand(budgetTxCmd)
+
+	budgetTxCmd.AddCommand(client.PostCommands(
+		GetCmdApproveMilestone(cdc),
+	)...)
+
+	// normal case all parameter given
+	_, err := testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`budget`,
+		`approve-milestone`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--program-id=1`,
+		`--milestone=0`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+}
+
 func TestQueryProgram(t *testing.T) {
 	cdc, _, _, _ := testutil.PrepareCmdTest()
 
@@ -166,6 +230,32 @@ func TestQueryVotes(t *testing.T) {
 	require.NotNil(t, voterFlag)
 }
 
+func TestQueryDelegatorVotes(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryDelegatorVotesCmd := GetCmdQueryDelegatorVotes(cdc)
+
+	// Name check
+	require.Equal(t, budget.QueryDelegatorVotes, queryDelegatorVotesCmd.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryDelegatorVotesCmd.Args))
+
+	// Check Flags
+	programFlag := queryDelegatorVotesCmd.Flag(flagProgramID)
+	require.NotNil(t, programFlag)
+
+	delegatorFlag := queryDelegatorVotesCmd.Flag(flagDelegator)
+	require.NotNil(t, delegatorFlag)
+	require.Equal(t, []string{"true"}, delegatorFlag.Annotations[cobra.BashCompOneRequiredFlag])
+
+	pageFlag := queryDelegatorVotesCmd.Flag(flagPage)
+	require.NotNil(t, pageFlag)
+
+	limitFlag := queryDelegatorVotesCmd.Flag(flagLimit)
+	require.NotNil(t, limitFlag)
+}
+
 func TestQueryParams(t *testing.T) {
 	cdc, _, _, _ := testutil.PrepareCmdTest()
 
@@ -175,6 +175,66 @@ $ terracli query budget votes --program-id 1 --voter terra1nk5lsuvy0rcfjcdr8au8z
 	return cmd
 }
 
+// GetCmdQueryDelegatorVotes implements the command to query for the program votes of a delegator.
+func GetCmdQueryDelegatorVotes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   budget.QueryDelegatorVotes,
+		Args:  cobra.NoArgs,
+		Short: "Query the votes cast with the delegations of a delegator, filtered by program id",
+		Long: strings.TrimSpace(`
+Query the votes cast with each delegation of a delegator, filtered by program id. A delegation votes
+as the delegator did, or inherits the vote of its validator if the delegator did not vote.
+
+Example:
+$ terracli query budget delegator-votes --delegator terra1nk5lsuvy0rcfjcdr8au8za0wq25rat0qa07p6t --program-id 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get delegator address
+			delegatorAddrStr := viper.GetString(flagDelegator)
+			delegatorAddress, err := sdk.AccAddressFromBech32(delegatorAddrStr)
+			if err != nil {
+				return err
+			}
+
+			programIDStr := viper.GetString(flagProgramID)
+
+			// validate that the program id is a uint
+			programID, err := strconv.ParseUint(programIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("program-id %s not a valid int, please input a valid program-id", programIDStr)
+			}
+
+			params := budget.NewQueryDelegatorVotesParams(delegatorAddress, programID, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", budget.QuerierRoute, budget.QueryDelegatorVotes), bz)
+			if err != nil {
+				return err
+			}
+
+			var delegatorVotes budget.QueryDelegatorVotesResponse
+			cdc.MustUnmarshalJSON(res, &delegatorVotes)
+
+			return cliCtx.PrintOutput(delegatorVotes)
+		},
+	}
+
+	cmd.Flags().String(flagProgramID, "0", "(optional) the program ID to query; defalut 0 for all programs")
+	cmd.Flags().String(flagDelegator, "", "delegator whose votes to query")
+	cmd.Flags().Int(flagPage, 1, "(optional) the page of delegator votes to query")
+	cmd.Flags().Int(flagLimit, budget.MaxDelegatorVotesLimit, "(optional) the number of delegator votes per page")
+
+	cmd.MarkFlagRequired(flagDelegator)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
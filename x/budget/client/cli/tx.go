@@ -24,23 +24,39 @@ const (
 	flagTitle       = "title"
 	flagDescription = "description"
 	flagExecutor    = "executor"
+	flagBudget      = "requested-budget"
+	flagCeiling     = "epoch-ceiling"
+	flagMilestone   = "milestone"
 	flagVoter       = "voter"
+	flagDelegator   = "delegator"
 	flagProgram     = "program"
 	flagProgramID   = "program-id"
 	flagOption      = "option"
 	flagOffline     = "offline"
+	flagPage        = "page"
+	flagLimit       = "limit"
 )
 
 type program struct {
-	Title       string
-	Description string
-	Executor    string
+	Title           string
+	Description     string
+	Executor        string
+	RequestedBudget string      `json:"requested_budget"`
+	EpochCeiling    string      `json:"epoch_ceiling"`
+	Milestones      []milestone `json:"milestones"`
+}
+
+type milestone struct {
+	Title  string
+	Amount string
 }
 
 var programFlags = []string{
 	flagTitle,
 	flagDescription,
 	flagExecutor,
+	flagBudget,
+	flagCeiling,
 }
 
 // GetCmdSubmitProgram implements submitting a program transaction command.
@@ -49,7 +65,7 @@ func GetCmdSubmitProgram(cdc *codec.Codec) *cobra.Command {
 		Use:   "submit-program",
 		Short: "Submit a program along with an initial deposit",
 		Long: strings.TrimSpace(`
-Submit a program along with an initial deposit. program title, description, type and deposit can be given directly or through a program JSON file. For example:
+Submit a program along with an initial deposit. program title, description, executor and requested budget can be given directly or through a program JSON file. The epoch ceiling caps the budget paid in an epoch and defaults to the whole requested budget. Milestones split the requested budget into tranches unlocked by validator approval; they can only be given through a program JSON file. For example:
 
 $ terracli budget submit-program --program="path/to/program.json" --from mykey
 
@@ -59,11 +75,17 @@ where program.json contains:
   "title": "Test program",
   "description": "My awesome program (include a website link for impact)",
   "executor": terra1nk5lsuvy0rcfjcdr8au8za0wq25rat0qa07p6t,
+  "requested_budget": "1000000000usdr",
+  "epoch_ceiling": "100000000",
+  "milestones": [
+    {"title": "Prototype", "amount": "400000000"},
+    {"title": "Launch", "amount": "600000000"}
+  ]
 }
 
-is equivalent to
+Without milestones, a program can be submitted with flags:
 
-$ terracli budget submit-program --title="Test program" --description="My awesome program" ... --from mykey
+$ terracli budget submit-program --title="Test program" --description="My awesome program" --requested-budget=1000000000usdr ... --from mykey
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			program, err := parseSubmitProgramFlags()
@@ -85,6 +107,11 @@ $ terracli budget submit-program --title="Test program" --description="My awesom
 				return err
 			}
 
+			requestedBudget, epochCeiling, milestones, err := parseProgramBudget(program)
+			if err != nil {
+				return err
+			}
+
 			offline := viper.GetBool(flagOffline)
 			if !offline {
 
@@ -120,7 +147,8 @@ $ terracli budget submit-program --title="Test program" --description="My awesom
 
 			}
 
-			msg := budget.NewMsgSubmitProgram(program.Title, program.Description, from, executorAddr)
+			msg := budget.NewMsgSubmitProgram(program.Title, program.Description, from, executorAddr,
+				requestedBudget, epochCeiling, milestones)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -133,6 +161,8 @@ $ terracli budget submit-program --title="Test program" --description="My awesom
 	cmd.Flags().String(flagTitle, "", "title of program")
 	cmd.Flags().String(flagDescription, "", "(optional) description of program")
 	cmd.Flags().String(flagExecutor, "", "executor of program")
+	cmd.Flags().String(flagBudget, "", "total budget requested by the program")
+	cmd.Flags().String(flagCeiling, "", "(optional) maximum amount of the requested budget paid in an epoch")
 	cmd.Flags().String(flagProgram, "", "program file path (if this path is given, other program flags are ignored)")
 	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
 
@@ -147,6 +177,8 @@ func parseSubmitProgramFlags() (*program, error) {
 		program.Title = viper.GetString(flagTitle)
 		program.Description = viper.GetString(flagDescription)
 		program.Executor = viper.GetString(flagExecutor)
+		program.RequestedBudget = viper.GetString(flagBudget)
+		program.EpochCeiling = viper.GetString(flagCeiling)
 
 		// Check title existence
 		if len(program.Title) == 0 {
@@ -158,6 +190,11 @@ func parseSubmitProgramFlags() (*program, error) {
 			return nil, fmt.Errorf("--%s flag is required", flagExecutor)
 		}
 
+		// Check requested budget existence
+		if len(program.RequestedBudget) == 0 {
+			return nil, fmt.Errorf("--%s flag is required", flagBudget)
+		}
+
 		return program, nil
 	}
 
@@ -180,13 +217,47 @@ func parseSubmitProgramFlags() (*program, error) {
 	return program, nil
 }
 
+func parseProgramBudget(program *program) (requestedBudget sdk.Coin, epochCeiling sdk.Int, milestones budget.Milestones, err error) {
+	requestedBudget, err = sdk.ParseCoin(program.RequestedBudget)
+	if err != nil {
+		return
+	}
+
+	// The ceiling defaults to the whole requested budget
+	epochCeiling = requestedBudget.Amount
+	if len(program.EpochCeiling) > 0 {
+		var ok bool
+		epochCeiling, ok = sdk.NewIntFromString(program.EpochCeiling)
+		if !ok {
+			err = fmt.Errorf("given epoch-ceiling {%s} is not a valid format; epoch-ceiling should be formatted as integer", program.EpochCeiling)
+			return
+		}
+	}
+
+	for _, m := range program.Milestones {
+		amount, ok := sdk.NewIntFromString(m.Amount)
+		if !ok {
+			err = fmt.Errorf("given milestone amount {%s} is not a valid format; amount should be formatted as integer", m.Amount)
+			return
+		}
+
+		milestones = append(milestones, budget.NewMilestone(m.Title, amount))
+	}
+
+	return
+}
+
 // GetCmdVote implements creating a new vote command.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vote",
-		Short: "Vote for an candidate/active program, options: yes or no",
+		Short: "Vote for an candidate/active program, options: yes/no/abstain/no_with_veto",
 		Long: strings.TrimSpace(`
-Submit a vote for an candidate/active program.
+Submit a vote for an candidate/active program. Validators and delegators can vote; the vote of a
+delegator overrides the vote of the validators it delegates to, for its delegated shares.
+
+Abstain votes only count toward the quorum. If the share of no_with_veto votes is above the veto
+threshold, the program is vetoed and the deposit of its submitter is burned.
 
 You can find the program-id of active programs by running terracli query budget actives
 You can find the program-id of candidate programs by running terracli query budget candidates
@@ -209,15 +280,18 @@ $ terracli tx budget vote --program-id 1  --option yes --from mykey
 				return fmt.Errorf("given program-id {%s} is not a valid format; program-id should be formatted as integer", programStrID)
 			}
 
-			// Find out which vote option user chose
-			var option bool
+			// Find out which vote option user chose; true and false are kept as aliases of yes and no
 			optionStr := viper.GetString(flagOption)
-			if optionStr == "yes" || optionStr == "true" {
-				option = true
-			} else if optionStr == "no" || optionStr == "false" {
-				option = false
-			} else {
-				return fmt.Errorf(`given option {%s} is not valid format;\n option should be formatted as "yes" or "no"`, optionStr)
+			switch optionStr {
+			case "true":
+				optionStr = "yes"
+			case "false":
+				optionStr = "no"
+			}
+
+			option, err := budget.VoteOptionFromString(optionStr)
+			if err != nil {
+				return fmt.Errorf(`given option {%s} is not valid format;\n option should be one of "yes", "no", "abstain" or "no_with_veto"`, optionStr)
 			}
 
 			offline := viper.GetBool(flagOffline)
@@ -243,7 +317,7 @@ $ terracli tx budget vote --program-id 1  --option yes --from mykey
 	cmd.MarkFlagRequired(flagOption)
 
 	cmd.Flags().String(flagProgramID, "", "the program ID to vote")
-	cmd.Flags().String(flagOption, "", "yes or no")
+	cmd.Flags().String(flagOption, "", "yes, no, abstain or no_with_veto")
 	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
 
 	return cmd
@@ -255,7 +329,8 @@ func GetCmdWithdrawProgram(cdc *codec.Codec) *cobra.Command {
 		Use:   "withdraw",
 		Short: "withdraw a program from consideration",
 		Long: strings.TrimSpace(`
-Withdraw a program from consideration. The deposit is only refunded if the program is already in the active set. 
+Withdraw a program from consideration. The deposit is only refunded if the program is still in the candidate set.
+A program whose no_with_veto votes are above the veto threshold cannot be withdrawn.
 
 $ terracli tx budget withdraw --program-id 1 
 `),
@@ -305,3 +380,129 @@ $ terracli tx budget withdraw --program-id 1
 
 	return cmd
 }
+
+// GetCmdClaimMilestone implements claiming a program milestone command.
+func GetCmdClaimMilestone(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-milestone",
+		Short: "claim that a milestone of a program is reached",
+		Long: strings.TrimSpace(`
+Claim that a milestone of a program is reached, as its executor. Milestones are indexed from 0 and claimed in order; the tranche is paid once validators approve the claim.
+
+$ terracli tx budget claim-milestone --program-id 1 --milestone 0 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get executor address
+			from := cliCtx.GetFromAddress()
+
+			programID, milestone, err := parseMilestoneFlags()
+			if err != nil {
+				return err
+			}
+
+			offline := viper.GetBool(flagOffline)
+
+			if !offline {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+			}
+
+			// Build claim message and run basic validation
+			msg := budget.NewMsgClaimMilestone(programID, milestone, from)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.MarkFlagRequired(flagProgramID)
+	cmd.MarkFlagRequired(flagMilestone)
+
+	cmd.Flags().String(flagProgramID, "", "the program ID of the milestone")
+	cmd.Flags().String(flagMilestone, "", "the index of the milestone to claim")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	return cmd
+}
+
+// GetCmdApproveMilestone implements approving a claimed program milestone command.
+func GetCmdApproveMilestone(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-milestone",
+		Short: "approve a claimed milestone of a program",
+		Long: strings.TrimSpace(`
+Approve a claimed milestone of a program, as a validator. The tranche is unlocked once the approving validators clear the active threshold.
+
+$ terracli tx budget approve-milestone --program-id 1 --milestone 0 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			programID, milestone, err := parseMilestoneFlags()
+			if err != nil {
+				return err
+			}
+
+			offline := viper.GetBool(flagOffline)
+
+			if !offline {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+			}
+
+			// Build approval message and run basic validation
+			msg := budget.NewMsgApproveMilestone(programID, milestone, from)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.MarkFlagRequired(flagProgramID)
+	cmd.MarkFlagRequired(flagMilestone)
+
+	cmd.Flags().String(flagProgramID, "", "the program ID of the milestone")
+	cmd.Flags().String(flagMilestone, "", "the index of the milestone to approve")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	return cmd
+}
+
+func parseMilestoneFlags() (programID uint64, milestone uint64, err error) {
+	// Validate that the program id is a uint
+	programStrID := viper.GetString(flagProgramID)
+	programID, err = strconv.ParseUint(programStrID, 10, 64)
+	if err != nil {
+		err = fmt.Errorf("given program-id {%s} is not a valid format; program-id should be formatted as integer", programStrID)
+		return
+	}
+
+	// Validate that the milestone index is a uint
+	milestoneStr := viper.GetString(flagMilestone)
+	milestone, err = strconv.ParseUint(milestoneStr, 10, 64)
+	if err != nil {
+		err = fmt.Errorf("given milestone {%s} is not a valid format; milestone should be formatted as integer", milestoneStr)
+		return
+	}
+
+	return
+}
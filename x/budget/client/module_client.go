@@ -29,6 +29,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		cli.GetCmdQueryActives(mc.cdc),
 		cli.GetCmdQueryCandidates(mc.cdc),
 		cli.GetCmdQueryVotes(mc.cdc),
+		cli.GetCmdQueryDelegatorVotes(mc.cdc),
 		cli.GetCmdQueryParams(mc.cdc),
 	)...)
 
@@ -46,6 +47,8 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 		cli.GetCmdSubmitProgram(mc.cdc),
 		cli.GetCmdWithdrawProgram(mc.cdc),
 		cli.GetCmdVote(mc.cdc),
+		cli.GetCmdClaimMilestone(mc.cdc),
+		cli.GetCmdApproveMilestone(mc.cdc),
 	)...)
 
 	return budgetTxCmd
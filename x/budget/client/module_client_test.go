@@ -14,17 +14,20 @@ const (
 
 var (
 	queryCmdList = map[string]bool{
-		"active-list":    true,
-		"candidate-list": true,
-		"params":         true,
-		"program":        true,
-		"votes":          true,
+		"active-list":     true,
+		"candidate-list":  true,
+		"params":          true,
+		"program":         true,
+		"votes":           true,
+		"delegator-votes": true,
 	}
 
 	txCmdList = map[string]bool{
-		"withdraw":       true,
-		"vote":           true,
-		"submit-program": true,
+		"withdraw":          true,
+		"vote":              true,
+		"submit-program":    true,
+		"claim-milestone":   true,
+		"approve-milestone": true,
 	}
 )
 
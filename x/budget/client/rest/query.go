@@ -21,6 +21,8 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}", RestProgramID), queryProgramHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/votes", RestProgramID), queryVotesHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/votes/{%s}", RestProgramID, RestVoter), queryVotesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/delegator-votes/{%s}", RestProgramID, RestDelegator), queryDelegatorVotesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/budget/delegators/{%s}/votes", RestDelegator), queryDelegatorVotesHandlerFn(cdc, cliCtx)).Methods("GET")
 
 	r.HandleFunc("/budget/params", queryParamsHandlerFn(cdc, cliCtx)).Methods("GET")
 }
@@ -118,6 +120,51 @@ func queryVotesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 	}
 }
 
+func queryDelegatorVotesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProgramID := vars[RestProgramID]
+		strDelegatorAddr := vars[RestDelegator]
+
+		delegatorAcc, err := sdk.AccAddressFromBech32(strDelegatorAddr)
+		if err != nil {
+			err := errors.New("delegator address malformed")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Votes on all programs unless a program id is given
+		var programID uint64
+		if len(strProgramID) != 0 {
+			var ok bool
+			programID, ok = rest.ParseUint64OrReturnBadRequest(w, strProgramID)
+			if !ok {
+				return
+			}
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(budget.NewQueryDelegatorVotesParams(delegatorAcc, programID, page, limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", budget.QuerierRoute, budget.QueryDelegatorVotes), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -11,6 +11,8 @@ import (
 const (
 	RestProgramID = "program-id"
 	RestVoter     = "voter"
+	RestMilestone = "milestone"
+	RestDelegator = "delegator"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
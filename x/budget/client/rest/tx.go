@@ -20,6 +20,8 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec
 	r.HandleFunc("/budget/programs/submit", submitProgramHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/withdraw", RestProgramID), withdrawProgramHandlerFn(cdc, cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/votes", RestProgramID), voteHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/milestones/{%s}/claim", RestProgramID, RestMilestone), claimMilestoneHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/budget/programs/{%s}/milestones/{%s}/approve", RestProgramID, RestMilestone), approveMilestoneHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 type submitProgramReq struct {
@@ -27,17 +29,25 @@ type submitProgramReq struct {
 	Title       string         `json:"title"`       //  Title of the Program
 	Description string         `json:"description"` //  Description of the Program
 	Executor    sdk.AccAddress `json:"executor"`    //  Address of the executor
+
+	RequestedBudget sdk.Coin          `json:"requested_budget"` //  Total budget requested by the Program
+	EpochCeiling    sdk.Int           `json:"epoch_ceiling"`    //  Maximum amount of the requested budget paid in an epoch
+	Milestones      budget.Milestones `json:"milestones"`       //  Optional tranches of the requested budget
 }
 
 type voteReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Option  bool         `json:"option"` //  option from OptionSet chosen by the voter
+	BaseReq rest.BaseReq      `json:"base_req"`
+	Option  budget.VoteOption `json:"option"` //  option from OptionSet chosen by the voter
 }
 
 type withdrawReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 }
 
+type milestoneReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
 func submitProgramHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req submitProgramReq
@@ -82,7 +92,8 @@ func submitProgramHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Ha
 		}
 
 		// create the message
-		msg := budget.NewMsgSubmitProgram(req.Title, req.Description, fromAddress, req.Executor)
+		msg := budget.NewMsgSubmitProgram(req.Title, req.Description, fromAddress, req.Executor,
+			req.RequestedBudget, req.EpochCeiling, req.Milestones)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -180,3 +191,73 @@ func voteHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func claimMilestoneHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		programID, milestone, req, fromAddress, ok := readMilestoneReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := budget.NewMsgClaimMilestone(programID, milestone, fromAddress)
+		err := msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func approveMilestoneHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		programID, milestone, req, fromAddress, ok := readMilestoneReq(w, r, cdc)
+		if !ok {
+			return
+		}
+
+		// create the message
+		msg := budget.NewMsgApproveMilestone(programID, milestone, fromAddress)
+		err := msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// readMilestoneReq parses the program id and milestone of the path, and the request body
+func readMilestoneReq(w http.ResponseWriter, r *http.Request, cdc *codec.Codec) (programID uint64, milestone uint64, req milestoneReq, fromAddress sdk.AccAddress, ok bool) {
+	vars := mux.Vars(r)
+
+	programID, ok = rest.ParseUint64OrReturnBadRequest(w, vars[RestProgramID])
+	if !ok {
+		return
+	}
+
+	milestone, ok = rest.ParseUint64OrReturnBadRequest(w, vars[RestMilestone])
+	if !ok {
+		return
+	}
+
+	if ok = rest.ReadRESTReq(w, r, cdc, &req); !ok {
+		return
+	}
+
+	baseReq := req.BaseReq.Sanitize()
+	if ok = baseReq.ValidateBasic(w); !ok {
+		return
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return programID, milestone, req, fromAddress, false
+	}
+
+	return
+}
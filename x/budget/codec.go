@@ -11,6 +11,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProgram{}, "budget/MsgSubmitProgram", nil)
 	cdc.RegisterConcrete(MsgWithdrawProgram{}, "budget/MsgWithdrawProgram", nil)
 	cdc.RegisterConcrete(MsgVoteProgram{}, "budget/MsgVoteProgram", nil)
+	cdc.RegisterConcrete(MsgClaimMilestone{}, "budget/MsgClaimMilestone", nil)
+	cdc.RegisterConcrete(MsgApproveMilestone{}, "budget/MsgApproveMilestone", nil)
 
 	cdc.RegisterConcrete(&Program{}, "budget/Program", nil)
 }
@@ -3,7 +3,6 @@ package budget
 import (
 	"strconv"
 
-	"github.com/terra-project/core/types"
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/util"
 	"github.com/terra-project/core/x/budget/tags"
@@ -11,30 +10,123 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Tally returns votePower = yesVotes minus NoVotes for program, as well as the total votes.
-// Power is denominated in validator bonded tokens (Luna stake size)
-func tally(ctx sdk.Context, k Keeper, targetProgramID uint64) (votePower sdk.Int, totalPower sdk.Int) {
-	votePower = sdk.ZeroInt()
+// Tally returns the voting power cast for each option on the program, as well as the total votes.
+// Power is denominated in validator bonded tokens (Luna stake size). Delegators vote with their
+// delegated shares, which override the vote of the validator they are delegated to; validators
+// vote with the bonded tokens of the delegations that did not override them. Votes of accounts
+// that no longer stake are deleted.
+func tally(ctx sdk.Context, k Keeper, targetProgramID uint64) (result TallyResult, totalPower sdk.Int) {
+	return tallyVotes(ctx, k, targetProgramID, true)
+}
+
+// peekTally returns the same result as tally without deleting any vote, for use outside of the EndBlocker
+func peekTally(ctx sdk.Context, k Keeper, targetProgramID uint64) (result TallyResult, totalPower sdk.Int) {
+	return tallyVotes(ctx, k, targetProgramID, false)
+}
+
+func tallyVotes(ctx sdk.Context, k Keeper, targetProgramID uint64, pruneVotes bool) (result TallyResult, totalPower sdk.Int) {
+	results := map[VoteOption]sdk.Dec{
+		OptionYes:        sdk.ZeroDec(),
+		OptionAbstain:    sdk.ZeroDec(),
+		OptionNo:         sdk.ZeroDec(),
+		OptionNoWithVeto: sdk.ZeroDec(),
+	}
 	totalPower = k.valset.TotalBondedTokens(ctx)
 
+	type validatorVote struct {
+		validator sdk.Validator
+		option    VoteOption
+	}
+
+	validatorVotes := []validatorVote{}
+	deductions := map[string]sdk.Dec{}
+
+	addPower := func(amount sdk.Dec, option VoteOption) {
+		results[option] = results[option].Add(amount)
+	}
+
 	targetProgramIDPrefix := keyVote(targetProgramID, sdk.AccAddress{})
-	k.IterateVotesWithPrefix(ctx, targetProgramIDPrefix, func(programID uint64, voter sdk.AccAddress, option bool) (stop bool) {
-		valAddr := sdk.ValAddress(voter)
+	k.IterateVotesWithPrefix(ctx, targetProgramIDPrefix, func(programID uint64, voter sdk.AccAddress, option VoteOption) (stop bool) {
+		validator := k.valset.Validator(ctx, sdk.ValAddress(voter))
+		if validator != nil {
+			validatorVotes = append(validatorVotes, validatorVote{validator: validator, option: option})
+		}
+
+		// The delegations of the voter override the votes of the validators they are delegated to
+		delegated := false
+		k.ds.IterateDelegations(ctx, voter, func(_ int64, delegation sdk.Delegation) (stop bool) {
+			delegatee := k.valset.Validator(ctx, delegation.GetValidatorAddr())
+			if delegatee == nil {
+				return false
+			}
+			delegated = true
 
-		if validator := k.valset.Validator(ctx, valAddr); validator != nil {
-			bondSize := validator.GetBondedTokens()
-			if option {
-				votePower = votePower.Add(bondSize)
+			// A validator without delegator shares has no tokens to vote with
+			if delegatee.GetDelegatorShares().IsZero() {
+				return false
+			}
+
+			valAddrStr := delegation.GetValidatorAddr().String()
+			if deduction, ok := deductions[valAddrStr]; ok {
+				deductions[valAddrStr] = deduction.Add(delegation.GetShares())
 			} else {
-				votePower = votePower.Sub(bondSize)
+				deductions[valAddrStr] = delegation.GetShares()
 			}
-		} else {
+
+			delegatorShare := delegation.GetShares().Quo(delegatee.GetDelegatorShares())
+			addPower(delegatorShare.MulInt(delegatee.GetBondedTokens()), option)
+
+			return false
+		})
+
+		// The voter has no stake left
+		if validator == nil && !delegated && pruneVotes {
 			k.DeleteVote(ctx, targetProgramID, voter)
 		}
 
 		return false
 	})
 
+	// Validators vote with the bonded tokens of the delegations that did not vote themselves
+	for _, vote := range validatorVotes {
+		if vote.validator.GetDelegatorShares().IsZero() {
+			continue
+		}
+
+		bondSize := sdk.NewDecFromInt(vote.validator.GetBondedTokens())
+		if deduction, ok := deductions[vote.validator.GetOperator().String()]; ok {
+			delegatorShares := vote.validator.GetDelegatorShares()
+			bondSize = delegatorShares.Sub(deduction).Quo(delegatorShares).Mul(bondSize)
+		}
+
+		addPower(bondSize, vote.option)
+	}
+
+	result = NewTallyResult(
+		results[OptionYes].TruncateInt(),
+		results[OptionAbstain].TruncateInt(),
+		results[OptionNo].TruncateInt(),
+		results[OptionNoWithVeto].TruncateInt(),
+	)
+	return
+}
+
+// tallyMilestone returns the bonded tokens of the validators that approved the milestone of a program,
+// as well as the total bonded tokens
+func tallyMilestone(ctx sdk.Context, k Keeper, programID uint64, milestone uint64) (approvalPower sdk.Int, totalPower sdk.Int) {
+	approvalPower = sdk.ZeroInt()
+	totalPower = k.valset.TotalBondedTokens(ctx)
+
+	k.IterateMilestoneApprovals(ctx, programID, milestone, func(voter sdk.AccAddress) (stop bool) {
+		if validator := k.valset.Validator(ctx, sdk.ValAddress(voter)); validator != nil {
+			approvalPower = approvalPower.Add(validator.GetBondedTokens())
+		} else {
+			k.DeleteMilestoneApproval(ctx, programID, milestone, voter)
+		}
+
+		return false
+	})
+
 	return
 }
 
@@ -43,6 +135,16 @@ func clearsThreshold(votePower, totalPower sdk.Int, threshold sdk.Dec) bool {
 	return votePower.GTE(threshold.MulInt(totalPower).RoundInt())
 }
 
+// isVetoed returns true if the share of the participating power that voted no with veto is above the veto threshold
+func isVetoed(result TallyResult, vetoThreshold sdk.Dec) bool {
+	participation := result.Participation()
+	if !participation.IsPositive() {
+		return false
+	}
+
+	return sdk.NewDecFromInt(result.NoWithVeto).QuoInt(participation).GT(vetoThreshold)
+}
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
 	params := k.GetParams(ctx)
@@ -54,18 +156,27 @@ func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
 			return false
 		}
 
-		// Did not pass the tally, delete program
-		votePower, totalPower := tally(ctx, k, programID)
+		result, totalPower := tally(ctx, k, programID)
+		votePower := result.VotePower()
 
-		if !clearsThreshold(votePower, totalPower, params.ActiveThreshold) {
-			k.DeleteVotesForProgram(ctx, programID)
-			k.DeleteProgram(ctx, programID)
-			resTags.AppendTag(tags.Action, tags.ActionProgramRejected)
-		} else {
-			resTags.AppendTag(tags.Action, tags.ActionProgramPassed)
+		// The deposit burned at submission is only refunded on withdrawal during the vote period
+		switch {
+		case isVetoed(result, params.VetoThreshold):
+			// Vetoed, delete program
+			k.deleteProgramAndVotes(ctx, programID)
+			resTags = resTags.AppendTag(tags.Action, tags.ActionProgramVetoed)
+
+		case !clearsThreshold(result.Participation(), totalPower, params.Quorum),
+			!clearsThreshold(votePower, totalPower, params.ActiveThreshold):
+			// Not enough voting power took part or did not pass the tally, delete program
+			k.deleteProgramAndVotes(ctx, programID)
+			resTags = resTags.AppendTag(tags.Action, tags.ActionProgramRejected)
+
+		default:
+			resTags = resTags.AppendTag(tags.Action, tags.ActionProgramPassed)
 		}
 
-		resTags.AppendTags(
+		resTags = resTags.AppendTags(
 			sdk.NewTags(
 				tags.ProgramID, strconv.FormatUint(programID, 10),
 				tags.Weight, votePower.String(),
@@ -76,26 +187,27 @@ func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
 		return false
 	})
 
-	// Time to re-weight programs
+	// Time to re-weight programs. Candidate votes end at SubmitBlock + VotePeriod, so the vote period
+	// stays counted in blocks whatever the calendar mode.
 	if util.IsPeriodLastBlock(ctx, params.VotePeriod) {
-		claims := types.ClaimPool{}
-
 		// iterate programs and weight them
 		k.IteratePrograms(ctx, true, func(program Program) (stop bool) {
-			votePower, totalPower := tally(ctx, k, program.ProgramID)
-
-			// Need to check if the program should be legacied
-			if !clearsThreshold(votePower, totalPower, params.LegacyThreshold) {
-				// Delete all votes on target program
-				k.DeleteVotesForProgram(ctx, program.ProgramID)
-				k.DeleteProgram(ctx, program.ProgramID)
-				resTags.AppendTag(tags.Action, tags.ActionProgramLegacied)
+			result, totalPower := tally(ctx, k, program.ProgramID)
+			votePower := result.VotePower()
+
+			// Need to check if the program should be vetoed or legacied
+			if isVetoed(result, params.VetoThreshold) {
+				k.deleteProgramAndVotes(ctx, program.ProgramID)
+				resTags = resTags.AppendTag(tags.Action, tags.ActionProgramVetoed)
+			} else if !clearsThreshold(votePower, totalPower, params.LegacyThreshold) {
+				k.deleteProgramAndVotes(ctx, program.ProgramID)
+				resTags = resTags.AppendTag(tags.Action, tags.ActionProgramLegacied)
 			} else {
-				claims = append(claims, types.NewClaim(votePower, program.Executor))
-				resTags.AppendTag(tags.Action, tags.ActionProgramGranted)
+				k.addClaim(ctx, program.ProgramID, votePower)
+				resTags = resTags.AppendTag(tags.Action, tags.ActionProgramGranted)
 			}
 
-			resTags.AppendTags(
+			resTags = resTags.AppendTags(
 				sdk.NewTags(
 					tags.ProgramID, strconv.FormatUint(program.ProgramID, 10),
 					tags.Weight, votePower.String(),
@@ -104,41 +216,61 @@ func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
 
 			return false
 		})
-
-		k.addClaimPool(ctx, claims)
 	}
 
 	// Time to distribute rewards to claims
-	if util.IsPeriodLastBlock(ctx, util.BlocksPerEpoch) {
-		epoch := util.GetEpoch(ctx)
-		rewardWeight := k.tk.GetRewardWeight(ctx, epoch)
-		seigniorage := k.mk.PeekEpochSeigniorage(ctx, epoch)
-		rewardPool := sdk.OneDec().Sub(rewardWeight).MulInt(seigniorage)
+	if k.ck.IsEpochLastBlock(ctx) {
+		// The treasury allocated the seigniorage of the epoch earlier in the block
+		epoch := k.ck.GetEpoch(ctx)
+		allocation := k.tk.GetSeigniorageAllocation(ctx, epoch)
+		rewardPool := sdk.NewDecFromInt(allocation.BudgetPrograms)
 
 		if rewardPool.GT(sdk.ZeroDec()) {
-			rewardPoolCoin, err := k.mrk.GetSwapDecCoin(ctx, sdk.NewDecCoinFromDec(assets.MicroLunaDenom, rewardPool), assets.MicroSDRDenom)
-			if err != nil {
-				// No SDR swap rate exists
-				rewardPoolCoin = sdk.NewDecCoinFromDec(assets.MicroLunaDenom, rewardPool)
-			}
+			// Seigniorage actually paid out, in luna
+			paid := sdk.ZeroDec()
 
 			weightSum := sdk.ZeroInt()
-			k.iterateClaimPool(ctx, func(_ sdk.AccAddress, weight sdk.Int) (stop bool) {
+			k.iterateClaimPool(ctx, func(_ uint64, weight sdk.Int) (stop bool) {
 				weightSum = weightSum.Add(weight)
 				return false
 			})
 
-			k.iterateClaimPool(ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
-				rewardAmt := rewardPoolCoin.Amount.MulInt(weight).QuoInt(weightSum).TruncateInt()
+			k.iterateClaimPool(ctx, func(programID uint64, weight sdk.Int) (stop bool) {
+				program, err := k.GetProgram(ctx, programID)
+				if err != nil {
+					// Withdrawn since the claim was made
+					return false
+				}
+
+				// Value the share of the program in the denom of its requested budget
+				share := sdk.NewDecCoinFromDec(assets.MicroLunaDenom, rewardPool.MulInt(weight).QuoInt(weightSum))
+				shareCoin, err := k.mrk.GetSwapDecCoin(ctx, share, program.RequestedBudget.Denom)
+				if err != nil {
+					// No swap rate exists for the requested denom
+					return false
+				}
+
+				// Never pay more than the epoch ceiling or what is left of the unlocked budget
+				rewardAmt := sdk.MinInt(shareCoin.Amount.TruncateInt(), program.payableAmount())
+				if !rewardAmt.IsPositive() {
+					return false
+				}
 
 				// never return err, but handle err for lint
-				err := k.mk.Mint(ctx, recipient, sdk.NewCoin(rewardPoolCoin.Denom, rewardAmt))
+				err = k.mk.Mint(ctx, program.Executor, sdk.NewCoin(program.RequestedBudget.Denom, rewardAmt))
 				if err != nil {
 					panic(err)
 				}
 
+				program.Disbursed = program.Disbursed.Add(rewardAmt)
+				k.StoreProgram(ctx, program)
+
+				paid = paid.Add(share.Amount.MulInt(rewardAmt).Quo(shareCoin.Amount))
 				return false
 			})
+
+			// Record what left the treasury; the unpaid remainder is burned
+			k.tk.SetSeigniorageAllocation(ctx, allocation.SettleBudgetPrograms(paid.TruncateInt()))
 		}
 
 		// Clear all claims
@@ -8,6 +8,8 @@ import (
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/mock"
 	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/budget/tags"
+	"github.com/terra-project/core/x/treasury"
 
 	"github.com/stretchr/testify/require"
 
@@ -30,15 +32,16 @@ func TestEndBlockerTallyBasic(t *testing.T) {
 		validator := mock.NewMockValidator(valAddr, sdk.OneInt())
 		valset.Validators = append(valset.Validators, validator)
 
-		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, true)
+		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, OptionYes)
 	}
 	input.budgetKeeper.valset = valset
+	input.budgetKeeper.ds = mock.NewMockDelegationSet()
 
-	actualVotePower, actualTotalPower := tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	actualResult, actualTotalPower := tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
 
 	// totalPower and votepower should match the number of validators (uniform, single weighted)
 	require.Equal(t, actualTotalPower, sdk.NewInt(int64(len(addrs))))
-	require.Equal(t, actualVotePower, sdk.NewInt(int64(len(addrs))))
+	require.Equal(t, actualResult.VotePower(), sdk.NewInt(int64(len(addrs))))
 }
 
 func TestEndBlockerTallyRandom(t *testing.T) {
@@ -54,7 +57,7 @@ func TestEndBlockerTallyRandom(t *testing.T) {
 	numValidators := rand.Int() % 100 // cap validator count by a 100
 
 	totalPower := 0
-	votePower := 0
+	optionPowers := map[VoteOption]int64{}
 	valset := mock.NewMockValSet()
 	for i := 0; i < numValidators; i++ {
 		valAccAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -65,22 +68,98 @@ func TestEndBlockerTallyRandom(t *testing.T) {
 
 		totalPower += valPower
 
-		option := rand.Int() % 2
-		choice := option == 1
-		if choice {
-			votePower += valPower
-		} else {
-			votePower -= valPower
-		}
+		option := []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto}[rand.Int()%4]
+		optionPowers[option] += int64(valPower)
 
-		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAccAddr, choice)
+		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAccAddr, option)
 	}
 	input.budgetKeeper.valset = valset
+	input.budgetKeeper.ds = mock.NewMockDelegationSet()
 
-	actualVotePower, actualTotalPower := tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	actualResult, actualTotalPower := tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
 
 	require.Equal(t, actualTotalPower, sdk.NewInt(int64(totalPower)))
-	require.Equal(t, actualVotePower, sdk.NewInt(int64(votePower)))
+	require.True(t, sdk.NewInt(optionPowers[OptionYes]).Equal(actualResult.Yes))
+	require.True(t, sdk.NewInt(optionPowers[OptionAbstain]).Equal(actualResult.Abstain))
+	require.True(t, sdk.NewInt(optionPowers[OptionNo]).Equal(actualResult.No))
+	require.True(t, sdk.NewInt(optionPowers[OptionNoWithVeto]).Equal(actualResult.NoWithVeto))
+
+	// Abstentions do not weigh on the program
+	votePower := optionPowers[OptionYes] - optionPowers[OptionNo] - optionPowers[OptionNoWithVeto]
+	require.True(t, sdk.NewInt(votePower).Equal(actualResult.VotePower()))
+}
+
+func TestEndBlockerTallyDelegators(t *testing.T) {
+	input := createTestInput(t)
+
+	testProgram := generateTestProgram(input.ctx, input.budgetKeeper)
+	input.budgetKeeper.StoreProgram(input.ctx, testProgram)
+
+	// Two validators, with 100 and 50 bonded tokens at one token per share
+	valAccAddrs := []sdk.AccAddress{}
+	valset := mock.NewMockValSet()
+	for _, power := range []int64{100, 50} {
+		valAccAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		valset.Validators = append(valset.Validators, mock.NewMockValidator(sdk.ValAddress(valAccAddr), sdk.NewInt(power)))
+		valAccAddrs = append(valAccAddrs, valAccAddr)
+	}
+	input.budgetKeeper.valset = valset
+
+	// The first delegator delegates 30 shares to the first validator, the second 20 and 10 to both
+	delAddrs := []sdk.AccAddress{
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	ds := mock.NewMockDelegationSet()
+	ds.Delegations = append(ds.Delegations,
+		mock.NewMockDelegation(delAddrs[0], sdk.ValAddress(valAccAddrs[0]), sdk.NewDec(30)),
+		mock.NewMockDelegation(delAddrs[1], sdk.ValAddress(valAccAddrs[0]), sdk.NewDec(20)),
+		mock.NewMockDelegation(delAddrs[1], sdk.ValAddress(valAccAddrs[1]), sdk.NewDec(10)),
+	)
+	input.budgetKeeper.ds = ds
+
+	// Delegators inherit the votes of their validators
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAccAddrs[0], OptionYes)
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAccAddrs[1], OptionYes)
+
+	result, totalPower := tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	require.Equal(t, sdk.NewInt(150), totalPower)
+	require.Equal(t, sdk.NewInt(150), result.VotePower())
+
+	// Delegators override the votes of their validators; 50 and 40 yes, 60 no
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, delAddrs[0], OptionNo)
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, delAddrs[1], OptionNo)
+
+	result, _ = tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	require.Equal(t, sdk.NewInt(30), result.VotePower())
+
+	// Delegators vote on their own when their validators do not
+	input.budgetKeeper.DeleteVote(input.ctx, testProgram.ProgramID, valAccAddrs[0])
+	input.budgetKeeper.DeleteVote(input.ctx, testProgram.ProgramID, valAccAddrs[1])
+
+	result, _ = tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	require.Equal(t, sdk.NewInt(-60), result.VotePower())
+
+	// Votes of accounts without stake are dropped
+	noStakeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, noStakeAddr, OptionYes)
+
+	result, _ = tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	require.Equal(t, sdk.NewInt(-60), result.VotePower())
+
+	_, err := input.budgetKeeper.GetVote(input.ctx, testProgram.ProgramID, noStakeAddr)
+	require.NotNil(t, err)
+
+	// Validators without delegator shares, and the delegations to them, add no power
+	emptyValAccAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	valset.Validators = append(valset.Validators, mock.NewMockValidator(sdk.ValAddress(emptyValAccAddr), sdk.ZeroInt()))
+	input.budgetKeeper.valset = valset
+	ds.Delegations = append(ds.Delegations, mock.NewMockDelegation(delAddrs[0], sdk.ValAddress(emptyValAccAddr), sdk.NewDec(5)))
+	input.budgetKeeper.ds = ds
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, emptyValAccAddr, OptionYes)
+
+	result, _ = tally(input.ctx, input.budgetKeeper, testProgram.ProgramID)
+	require.Equal(t, sdk.NewInt(-60), result.VotePower())
 }
 
 func TestEndBlockerTiming(t *testing.T) {
@@ -93,7 +172,7 @@ func TestEndBlockerTiming(t *testing.T) {
 
 	// Add a vote each from validators
 	for _, addr := range addrs {
-		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, true)
+		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, OptionYes)
 	}
 
 	// No claims should have been settled yet
@@ -110,7 +189,7 @@ func TestEndBlockerTiming(t *testing.T) {
 	claimCount = countClaimPool(input.ctx, input.budgetKeeper)
 	require.Equal(t, 1, claimCount)
 
-	input.budgetKeeper.iterateClaimPool(input.ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
+	input.budgetKeeper.iterateClaimPool(input.ctx, func(programID uint64, weight sdk.Int) (stop bool) {
 		require.Equal(t, input.budgetKeeper.valset.TotalBondedTokens(input.ctx), weight)
 		return true
 	})
@@ -127,7 +206,7 @@ func TestEndBlockerClaimDistribution(t *testing.T) {
 
 	// Add a vote each from validators
 	for _, addr := range addrs {
-		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, true)
+		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addr, OptionYes)
 	}
 
 	// No claims should have been settled yet
@@ -144,15 +223,91 @@ func TestEndBlockerClaimDistribution(t *testing.T) {
 	claimCount = countClaimPool(input.ctx, input.budgetKeeper)
 	require.Equal(t, 1, claimCount)
 
-	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(1000)))
-
 	// after 5 week, distribution date reach
 	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch*5 - 1)
-	input.treasuryKeeper.SetRewardWeight(input.ctx, sdk.NewDecWithPrec(1, 1))
+	epoch := input.budgetKeeper.ck.GetEpoch(input.ctx)
+
+	// The treasury allocated part of the seigniorage of the epoch to budget programs
+	allocation := treasury.NewSeigniorageAllocation(epoch, sdk.NewInt(100), sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroInt(), sdk.ZeroInt())
+	input.treasuryKeeper.SetSeigniorageAllocation(input.ctx, allocation)
+
+	balance := input.bankKeeper.GetCoins(input.ctx, testProgram.Executor).AmountOf(assets.MicroLunaDenom)
 	EndBlocker(input.ctx, input.budgetKeeper)
 
 	claimCount = countClaimPool(input.ctx, input.budgetKeeper)
 	require.Equal(t, 0, claimCount)
+
+	// No SDR swap rate exists; the sole claim gets the whole allocation in Luna
+	require.Equal(t, balance.AddRaw(1000), input.bankKeeper.GetCoins(input.ctx, testProgram.Executor).AmountOf(assets.MicroLunaDenom))
+	require.Equal(t, sdk.NewInt(1000), input.treasuryKeeper.GetSeigniorageAllocation(input.ctx, epoch).BudgetPrograms)
+}
+
+func TestEndBlockerClaimDistributionCeiling(t *testing.T) {
+	input := createTestInput(t)
+
+	// Program requesting 1000 uluna, at most 300 per epoch
+	testProgram := generateTestProgram(input.ctx, input.budgetKeeper)
+	testProgram.RequestedBudget = sdk.NewInt64Coin(assets.MicroLunaDenom, 1000)
+	testProgram.EpochCeiling = sdk.NewInt(300)
+	input.budgetKeeper.StoreProgram(input.ctx, testProgram)
+
+	// Program whose budget is locked behind an unapproved milestone
+	lockedProgram := generateTestProgram(input.ctx, input.budgetKeeper)
+	lockedProgram.RequestedBudget = sdk.NewInt64Coin(assets.MicroLunaDenom, 1000)
+	lockedProgram.EpochCeiling = sdk.NewInt(1000)
+	lockedProgram.Milestones = Milestones{NewMilestone("first", sdk.NewInt(1000))}
+	input.budgetKeeper.StoreProgram(input.ctx, lockedProgram)
+
+	distribute := func(epoch int64) {
+		input.budgetKeeper.addClaim(input.ctx, testProgram.ProgramID, sdk.OneInt())
+		input.budgetKeeper.addClaim(input.ctx, lockedProgram.ProgramID, sdk.OneInt())
+
+		input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch*(epoch+1) - 1)
+		allocation := treasury.NewSeigniorageAllocation(sdk.NewInt(epoch), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(2000), sdk.ZeroInt(), sdk.ZeroInt())
+		input.treasuryKeeper.SetSeigniorageAllocation(input.ctx, allocation)
+
+		EndBlocker(input.ctx, input.budgetKeeper)
+	}
+
+	executorBalance := func() sdk.Int {
+		return input.bankKeeper.GetCoins(input.ctx, testProgram.Executor).AmountOf(assets.MicroLunaDenom)
+	}
+
+	// Both programs share the executor; each share is 1000 uluna, but only 300 is paid
+	balance := executorBalance()
+	for epoch := int64(0); epoch < 3; epoch++ {
+		distribute(epoch)
+		require.Equal(t, balance.AddRaw(300*(epoch+1)), executorBalance())
+	}
+
+	// Only what was paid is recorded as paid to budget programs; the rest is burned
+	allocation := input.treasuryKeeper.GetSeigniorageAllocation(input.ctx, sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(300), allocation.BudgetPrograms)
+	require.Equal(t, sdk.NewInt(1700), allocation.Burned)
+
+	// The last epoch pays what is left of the requested budget
+	distribute(3)
+	require.Equal(t, balance.AddRaw(1000), executorBalance())
+
+	program, err := input.budgetKeeper.GetProgram(input.ctx, testProgram.ProgramID)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(1000), program.Disbursed)
+
+	// Nothing more is paid once the budget is spent
+	distribute(4)
+	require.Equal(t, balance.AddRaw(1000), executorBalance())
+
+	// Approving the milestone unlocks the budget of the locked program
+	lockedProgram, err = input.budgetKeeper.GetProgram(input.ctx, lockedProgram.ProgramID)
+	require.Nil(t, err)
+	require.True(t, lockedProgram.Disbursed.IsZero())
+
+	lockedProgram.Milestones[0].Claimed = true
+	lockedProgram.Milestones[0].Approved = true
+	input.budgetKeeper.StoreProgram(input.ctx, lockedProgram)
+
+	distribute(5)
+	require.Equal(t, balance.AddRaw(2000), executorBalance())
 }
 
 func TestEndBlockerLegacy(t *testing.T) {
@@ -171,22 +326,24 @@ func TestEndBlockerLegacy(t *testing.T) {
 
 	// Add a vote each from validators
 	for _, addr := range addrs {
-		input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addr, true)
+		input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addr, OptionYes)
 	}
 
 	// Claims should have been settled
-	EndBlocker(ctx, input.budgetKeeper)
+	resTags := EndBlocker(ctx, input.budgetKeeper)
+	require.Equal(t, []byte(tags.ActionProgramGranted), resTags.ToKVPairs()[0].GetValue())
 	claimCount := countClaimPool(input.ctx, input.budgetKeeper)
 	require.Equal(t, 1, claimCount)
 
 	ctx = input.ctx.WithBlockHeight(2)
 
 	for _, addr := range addrs {
-		input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addr, false)
+		input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addr, OptionNo)
 	}
 
 	// Program should be legacy
-	EndBlocker(ctx, input.budgetKeeper)
+	resTags = EndBlocker(ctx, input.budgetKeeper)
+	require.Equal(t, []byte(tags.ActionProgramLegacied), resTags.ToKVPairs()[0].GetValue())
 	_, err := input.budgetKeeper.GetProgram(ctx, testProgram.ProgramID)
 	require.Error(t, err)
 }
@@ -204,6 +361,7 @@ func TestEndBlockerPassOrReject(t *testing.T) {
 		valAddrs = append(valAddrs, valAccAddr)
 	}
 	input.budgetKeeper.valset = valset
+	input.budgetKeeper.ds = mock.NewMockDelegationSet()
 
 	// Compute minimum validator support
 	activeThreshold := input.budgetKeeper.GetParams(input.ctx).ActiveThreshold
@@ -216,7 +374,7 @@ func TestEndBlockerPassOrReject(t *testing.T) {
 
 	// vote slightly such that the sum falls short of the threshold; tally should fail and program not activated.
 	for i := 0; i < int(minNumTokensToPass.Int64())-1; i++ {
-		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAddrs[i], true)
+		input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAddrs[i], OptionYes)
 	}
 
 	params := input.budgetKeeper.GetParams(input.ctx)
@@ -233,7 +391,7 @@ func TestEndBlockerPassOrReject(t *testing.T) {
 	input.budgetKeeper.CandQueueInsert(input.ctx, testProgram2.getVotingEndBlock(input.ctx, input.budgetKeeper), testProgram2.ProgramID)
 
 	for i := 0; i < int(minNumTokensToPass.Int64())+1; i++ {
-		input.budgetKeeper.AddVote(input.ctx, testProgram2.ProgramID, valAddrs[i], true)
+		input.budgetKeeper.AddVote(input.ctx, testProgram2.ProgramID, valAddrs[i], OptionYes)
 	}
 
 	input.ctx = input.ctx.WithBlockHeight(params.VotePeriod)
@@ -242,8 +400,102 @@ func TestEndBlockerPassOrReject(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestEndBlockerVetoAndQuorum(t *testing.T) {
+	input := createTestInput(t)
+
+	// add a hundred validators with 1 stakable token each
+	valset := mock.NewMockValSet()
+	valAddrs := []sdk.AccAddress{}
+	for i := 0; i < 100; i++ {
+		valAccAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		validator := mock.NewMockValidator(sdk.ValAddress(valAccAddr), sdk.OneInt())
+		valset.Validators = append(valset.Validators, validator)
+		valAddrs = append(valAddrs, valAccAddr)
+	}
+	input.budgetKeeper.valset = valset
+	input.budgetKeeper.ds = mock.NewMockDelegationSet()
+
+	params := input.budgetKeeper.GetParams(input.ctx)
+	submitter := addrs[2]
+	submitterBalance := func() sdk.Int {
+		return input.bankKeeper.GetCoins(input.ctx, submitter).AmountOf(params.Deposit.Denom)
+	}
+
+	// Votes on a candidate program, settled at the end of its voting period
+	settle := func(options ...VoteOption) (Program, sdk.Tags, sdk.Error) {
+		testProgram := generateTestProgram(input.ctx, input.budgetKeeper, submitter)
+		input.budgetKeeper.StoreProgram(input.ctx, testProgram)
+		input.budgetKeeper.CandQueueInsert(input.ctx, testProgram.getVotingEndBlock(input.ctx, input.budgetKeeper), testProgram.ProgramID)
+
+		for i, option := range options {
+			input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, valAddrs[i], option)
+		}
+
+		resTags := EndBlocker(input.ctx.WithBlockHeight(params.VotePeriod), input.budgetKeeper)
+		program, err := input.budgetKeeper.GetProgram(input.ctx, testProgram.ProgramID)
+		return program, resTags, err
+	}
+
+	repeat := func(option VoteOption, n int) (options []VoteOption) {
+		for i := 0; i < n; i++ {
+			options = append(options, option)
+		}
+		return
+	}
+
+	// The deposit stays burned whatever the outcome of the tally
+	balance := submitterBalance()
+
+	// Abstentions count toward the quorum only; 20 yes clear the active threshold
+	_, resTags, err := settle(append(repeat(OptionYes, 20), repeat(OptionAbstain, 60)...)...)
+	require.Nil(t, err)
+	require.Equal(t, []byte(tags.ActionProgramPassed), resTags.ToKVPairs()[0].GetValue())
+	require.Equal(t, balance, submitterBalance())
+
+	// More than a third of the participating power vetoes; the program is deleted
+	_, resTags, err = settle(append(repeat(OptionYes, 60), repeat(OptionNoWithVeto, 40)...)...)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(tags.ActionProgramVetoed), resTags.ToKVPairs()[0].GetValue())
+	require.Equal(t, balance, submitterBalance())
+
+	// Too few validators vote; the program is rejected
+	_, resTags, err = settle(repeat(OptionYes, 9)...)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(tags.ActionProgramRejected), resTags.ToKVPairs()[0].GetValue())
+	require.Equal(t, balance, submitterBalance())
+
+	// The quorum is met but the program falls short of the active threshold
+	_, resTags, err = settle(append(repeat(OptionYes, 15), repeat(OptionNo, 10)...)...)
+	require.NotNil(t, err)
+	require.Equal(t, []byte(tags.ActionProgramRejected), resTags.ToKVPairs()[0].GetValue())
+	require.Equal(t, balance, submitterBalance())
+}
+
+func TestEndBlockerVetoActive(t *testing.T) {
+	input := createTestInput(t)
+
+	defaultBudgetParams := DefaultParams()
+	defaultBudgetParams.VotePeriod = 1
+	input.budgetKeeper.SetParams(input.ctx, defaultBudgetParams)
+
+	ctx := input.ctx.WithBlockHeight(1)
+
+	testProgram := generateTestProgram(ctx, input.budgetKeeper)
+	input.budgetKeeper.StoreProgram(ctx, testProgram)
+
+	// The program clears the legacy threshold, but half of the participating power vetoes it
+	input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addrs[0], OptionYes)
+	input.budgetKeeper.AddVote(ctx, testProgram.ProgramID, addrs[1], OptionNoWithVeto)
+
+	resTags := EndBlocker(ctx, input.budgetKeeper)
+	require.Equal(t, []byte(tags.ActionProgramVetoed), resTags.ToKVPairs()[0].GetValue())
+	_, err := input.budgetKeeper.GetProgram(ctx, testProgram.ProgramID)
+	require.Error(t, err)
+	require.Equal(t, 0, countClaimPool(ctx, input.budgetKeeper))
+}
+
 func countClaimPool(ctx sdk.Context, keeper Keeper) (claimCount int) {
-	keeper.iterateClaimPool(ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
+	keeper.iterateClaimPool(ctx, func(programID uint64, weight sdk.Int) (stop bool) {
 		claimCount++
 		return false
 	})
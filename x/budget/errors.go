@@ -21,6 +21,14 @@ const (
 	CodeRefundFailed             sdk.CodeType = 7
 	CodeInvalidSubmitBlockHeight sdk.CodeType = 8
 	CodeDuplicateProgramID       sdk.CodeType = 9
+	CodeInvalidBudget            sdk.CodeType = 10
+	CodeMilestoneNotFound        sdk.CodeType = 11
+	CodeInvalidExecutor          sdk.CodeType = 12
+	CodeMilestoneNotClaimable    sdk.CodeType = 13
+	CodeMilestoneNotPending      sdk.CodeType = 14
+	CodeInvalidOption            sdk.CodeType = 15
+	CodeProgramVetoed            sdk.CodeType = 16
+	CodeUnknownDenom             sdk.CodeType = 17
 )
 
 // nolint
@@ -69,3 +77,43 @@ func ErrInvalidSubmitBlockHeight(submitBlock int64) sdk.Error {
 func ErrDuplicateProgramID(programID uint64) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeDuplicateProgramID, fmt.Sprintf("program ID is duplicated %d", programID))
 }
+
+// nolint
+func ErrInvalidBudget(reason string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidBudget, fmt.Sprintf("Invalid budget: %s", reason))
+}
+
+// nolint
+func ErrMilestoneNotFound(programID uint64, milestone uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMilestoneNotFound, fmt.Sprintf("milestone %d of program %d not found", milestone, programID))
+}
+
+// nolint
+func ErrInvalidExecutor(executor sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidExecutor, fmt.Sprintf("Executor does not match %s", executor))
+}
+
+// nolint
+func ErrMilestoneNotClaimable(programID uint64, milestone uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMilestoneNotClaimable, fmt.Sprintf("milestone %d of program %d is already claimed or follows an unapproved milestone", milestone, programID))
+}
+
+// nolint
+func ErrMilestoneNotPending(programID uint64, milestone uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMilestoneNotPending, fmt.Sprintf("milestone %d of program %d is not awaiting approval", milestone, programID))
+}
+
+// nolint
+func ErrInvalidOption(option VoteOption) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidOption, fmt.Sprintf("Invalid vote option %v", byte(option)))
+}
+
+// nolint
+func ErrUnknownDenom(denom string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeUnknownDenom, fmt.Sprintf("Requested budget denom %s is not whitelisted by the oracle", denom))
+}
+
+// nolint
+func ErrProgramVetoed(programID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeProgramVetoed, fmt.Sprintf("program %d is vetoed by its current tally and cannot be withdrawn", programID))
+}
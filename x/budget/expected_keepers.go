@@ -1,6 +1,8 @@
 package budget
 
 import (
+	"github.com/terra-project/core/x/treasury"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,13 +10,23 @@ import (
 type MintKeeper interface {
 	Mint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
 	Burn(ctx sdk.Context, payer sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
-	PeekEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) (epochSeigniorage sdk.Int)
 }
 
 // expected treasury keeper
 type TreasuryKeeper interface {
-	GetRewardWeight(ctx sdk.Context, epoch sdk.Int) (rewardWeight sdk.Dec)
-	SetRewardWeight(ctx sdk.Context, weight sdk.Dec)
+	GetSeigniorageAllocation(ctx sdk.Context, epoch sdk.Int) (allocation treasury.SeigniorageAllocation)
+	SetSeigniorageAllocation(ctx sdk.Context, allocation treasury.SeigniorageAllocation)
+}
+
+// expected calendar keeper
+type CalendarKeeper interface {
+	GetEpoch(ctx sdk.Context) sdk.Int
+	IsEpochLastBlock(ctx sdk.Context) bool
+}
+
+// expected oracle keeper
+type OracleKeeper interface {
+	GetLunaSwapRate(ctx sdk.Context, denom string) (price sdk.Dec, err sdk.Error)
 }
 
 // expected market keeper
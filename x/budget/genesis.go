@@ -1,6 +1,8 @@
 package budget
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,16 +14,21 @@ type GenesisState struct {
 	CandidatePrograms Programs `json:"candidate_programs"`
 
 	Votes Votes `json:"votes"`
+
+	MilestoneApprovals MilestoneApprovals `json:"milestone_approvals"` // approvals of the claimed milestones
+	ClaimPool          Claims             `json:"claim_pool"`          // claims of the current epoch, paid at its end
 }
 
 func NewGenesisState(params Params, activePrograms,
-	candidatePrograms Programs, votes Votes) GenesisState {
+	candidatePrograms Programs, votes Votes, milestoneApprovals MilestoneApprovals, claimPool Claims) GenesisState {
 	return GenesisState{
 		Params: params,
 
-		ActivePrograms:    activePrograms,
-		CandidatePrograms: candidatePrograms,
-		Votes:             votes,
+		ActivePrograms:     activePrograms,
+		CandidatePrograms:  candidatePrograms,
+		Votes:              votes,
+		MilestoneApprovals: milestoneApprovals,
+		ClaimPool:          claimPool,
 	}
 }
 
@@ -30,9 +37,11 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params: DefaultParams(),
 
-		ActivePrograms:    Programs{},
-		CandidatePrograms: Programs{},
-		Votes:             Votes{},
+		ActivePrograms:     Programs{},
+		CandidatePrograms:  Programs{},
+		Votes:              Votes{},
+		MilestoneApprovals: MilestoneApprovals{},
+		ClaimPool:          Claims{},
 	}
 }
 
@@ -53,6 +62,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.AddVote(ctx, vote.ProgramID, vote.Voter, vote.Option)
 	}
 
+	for _, approval := range data.MilestoneApprovals {
+		keeper.AddMilestoneApproval(ctx, approval.ProgramID, approval.Milestone, approval.Voter)
+	}
+
+	for _, claim := range data.ClaimPool {
+		keeper.addClaim(ctx, claim.ProgramID, claim.Weight)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper. The
@@ -78,12 +94,24 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	})
 
 	var votes Votes
-	keeper.IterateVotes(ctx, func(programID uint64, voterAddr sdk.AccAddress, option bool) (stop bool) {
+	keeper.IterateVotes(ctx, func(programID uint64, voterAddr sdk.AccAddress, option VoteOption) (stop bool) {
 		votes = append(votes, NewVote(programID, option, voterAddr))
 		return false
 	})
 
-	return NewGenesisState(params, activePrograms, candidatePrograms, votes)
+	var milestoneApprovals MilestoneApprovals
+	keeper.IterateAllMilestoneApprovals(ctx, func(programID uint64, milestone uint64, voter sdk.AccAddress) (stop bool) {
+		milestoneApprovals = append(milestoneApprovals, NewMilestoneApproval(programID, milestone, voter))
+		return false
+	})
+
+	var claimPool Claims
+	keeper.iterateClaimPool(ctx, func(programID uint64, weight sdk.Int) (stop bool) {
+		claimPool = append(claimPool, NewClaim(programID, weight))
+		return false
+	})
+
+	return NewGenesisState(params, activePrograms, candidatePrograms, votes, milestoneApprovals, claimPool)
 }
 
 // ValidateGenesis validates the provided oracle genesis state to ensure the
@@ -95,12 +123,16 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	var programMap map[uint64]bool
+	programMap := map[uint64]bool{}
 	for _, program := range data.ActivePrograms {
 		if program.SubmitBlock != 0 {
 			return ErrInvalidSubmitBlockHeight(program.SubmitBlock)
 		}
 
+		if err := validateProgramBudget(program); err != nil {
+			return err
+		}
+
 		// duplicate program ID check
 		if _, ok := programMap[program.ProgramID]; ok {
 			return ErrDuplicateProgramID(program.ProgramID)
@@ -114,6 +146,10 @@ func ValidateGenesis(data GenesisState) error {
 			return ErrInvalidSubmitBlockHeight(program.SubmitBlock)
 		}
 
+		if err := validateProgramBudget(program); err != nil {
+			return err
+		}
+
 		// duplicate program ID check
 		if _, ok := programMap[program.ProgramID]; ok {
 			return ErrDuplicateProgramID(program.ProgramID)
@@ -126,6 +162,41 @@ func ValidateGenesis(data GenesisState) error {
 		if _, ok := programMap[vote.ProgramID]; !ok {
 			return ErrProgramNotFound(vote.ProgramID)
 		}
+
+		if !ValidVoteOption(vote.Option) {
+			return ErrInvalidOption(vote.Option)
+		}
+	}
+
+	for _, approval := range data.MilestoneApprovals {
+		if _, ok := programMap[approval.ProgramID]; !ok {
+			return ErrProgramNotFound(approval.ProgramID)
+		}
+	}
+
+	claimMap := map[uint64]bool{}
+	for _, claim := range data.ClaimPool {
+		if _, ok := programMap[claim.ProgramID]; !ok {
+			return ErrProgramNotFound(claim.ProgramID)
+		}
+
+		if !claim.Weight.IsPositive() || claimMap[claim.ProgramID] {
+			return fmt.Errorf("Invalid claim of program %d with weight %s", claim.ProgramID, claim.Weight)
+		}
+		claimMap[claim.ProgramID] = true
+	}
+
+	return nil
+}
+
+// validateProgramBudget checks the requested budget of a genesis program and what was disbursed of it
+func validateProgramBudget(program Program) error {
+	if err := validateBudget(program.RequestedBudget, program.EpochCeiling, program.Milestones); err != nil {
+		return err
+	}
+
+	if program.Disbursed.IsNegative() || program.Disbursed.GT(program.RequestedBudget.Amount) {
+		return fmt.Errorf("Invalid disbursed amount %s for program %d", program.Disbursed, program.ProgramID)
 	}
 
 	return nil
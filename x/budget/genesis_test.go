@@ -0,0 +1,36 @@
+package budget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input := createTestInput(t)
+
+	program := generateTestProgram(input.ctx, input.budgetKeeper)
+	program.SubmitBlock = 0
+	program.Milestones = Milestones{NewMilestone("first", sdk.NewInt(400000)), NewMilestone("second", sdk.NewInt(600000))}
+	program.Milestones[0].Claimed = true
+	input.budgetKeeper.StoreProgram(input.ctx, program)
+
+	// A claimed milestone awaiting more approvals and a claim not yet paid
+	input.budgetKeeper.AddMilestoneApproval(input.ctx, program.ProgramID, 0, addrs[2])
+	input.budgetKeeper.addClaim(input.ctx, program.ProgramID, sdk.NewInt(30))
+
+	genesis := ExportGenesis(input.ctx, input.budgetKeeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Equal(t, MilestoneApprovals{NewMilestoneApproval(program.ProgramID, 0, addrs[2])}, genesis.MilestoneApprovals)
+	require.Equal(t, Claims{NewClaim(program.ProgramID, sdk.NewInt(30))}, genesis.ClaimPool)
+
+	newInput := createTestInput(t)
+	InitGenesis(newInput.ctx, newInput.budgetKeeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(newInput.ctx, newInput.budgetKeeper))
+
+	// Approvals and claims must refer to a program of the genesis
+	genesis.ClaimPool = Claims{NewClaim(program.ProgramID+1, sdk.NewInt(30))}
+	require.Error(t, ValidateGenesis(genesis))
+}
@@ -20,6 +20,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgWithdrawProgram(ctx, k, msg)
 		case MsgVoteProgram:
 			return handleMsgVoteProgram(ctx, k, msg)
+		case MsgClaimMilestone:
+			return handleMsgClaimMilestone(ctx, k, msg)
+		case MsgApproveMilestone:
+			return handleMsgApproveMilestone(ctx, k, msg)
 
 		default:
 			errMsg := "Unrecognized budget Msg type: " + reflect.TypeOf(msg).Name()
@@ -31,12 +35,23 @@ func NewHandler(k Keeper) sdk.Handler {
 // handleMsgVoteProgram handles the logic of a MsgSubmitProgram
 func handleMsgSubmitProgram(ctx sdk.Context, k Keeper, msg MsgSubmitProgram) sdk.Result {
 
+	// Programs are paid in the denom of their requested budget, which needs a swap rate
+	if _, err := k.ok.GetLunaSwapRate(ctx, msg.RequestedBudget.Denom); err != nil {
+		return ErrUnknownDenom(msg.RequestedBudget.Denom).Result()
+	}
+
 	// Subtract coins from the submitter balance and updates it
 	depositErr := k.PayDeposit(ctx, msg.Submitter)
 	if depositErr != nil {
 		return depositErr.Result()
 	}
 
+	// Milestones start unclaimed, whatever the submitted state
+	milestones := make(Milestones, len(msg.Milestones))
+	for i, milestone := range msg.Milestones {
+		milestones[i] = NewMilestone(milestone.Title, milestone.Amount)
+	}
+
 	// Create and add program
 	programID := k.NewProgramID(ctx)
 	program := NewProgram(
@@ -46,6 +61,9 @@ func handleMsgSubmitProgram(ctx sdk.Context, k Keeper, msg MsgSubmitProgram) sdk
 		msg.Submitter,
 		msg.Executor,
 		ctx.BlockHeight(),
+		msg.RequestedBudget,
+		msg.EpochCeiling,
+		milestones,
 	)
 
 	k.StoreProgram(ctx, program)
@@ -70,6 +88,12 @@ func handleMsgWithdrawProgram(ctx sdk.Context, k Keeper, msg MsgWithdrawProgram)
 		return ErrInvalidSubmitter(msg.Submitter).Result()
 	}
 
+	// A vetoed program cannot be withdrawn to escape the veto and recover the deposit
+	result, _ := peekTally(ctx, k, msg.ProgramID)
+	if isVetoed(result, k.GetParams(ctx).VetoThreshold) {
+		return ErrProgramVetoed(msg.ProgramID).Result()
+	}
+
 	// Remove from candidate queue if not yet active
 	prgmEndBlock := program.getVotingEndBlock(ctx, k)
 	if k.CandQueueHas(ctx, prgmEndBlock, msg.ProgramID) {
@@ -81,8 +105,9 @@ func handleMsgWithdrawProgram(ctx sdk.Context, k Keeper, msg MsgWithdrawProgram)
 		}
 	}
 
-	// Delete all votes on target program
+	// Delete all votes and milestone approvals on target program
 	k.DeleteVotesForProgram(ctx, msg.ProgramID)
+	k.DeleteMilestoneApprovalsForProgram(ctx, msg.ProgramID)
 	k.DeleteProgram(ctx, msg.ProgramID)
 
 	return sdk.Result{
@@ -101,20 +126,101 @@ func handleMsgVoteProgram(ctx sdk.Context, k Keeper, msg MsgVoteProgram) sdk.Res
 		return ErrProgramNotFound(msg.ProgramID).Result()
 	}
 
+	// Check the voter is a validator or delegates to one
+	if !k.isStaker(ctx, msg.Voter) {
+		return staking.ErrNoDelegatorForAddress(DefaultCodespace).Result()
+	}
+
+	k.AddVote(ctx, msg.ProgramID, msg.Voter, msg.Option)
+
+	return sdk.Result{
+		Tags: resTags.AppendTags(
+			sdk.NewTags(
+				tags.ProgramID, strconv.FormatUint(msg.ProgramID, 10),
+				tags.Voter, msg.Voter.String(),
+				tags.Option, msg.Option.String(),
+			),
+		),
+	}
+}
+
+// handleMsgClaimMilestone handles the logic of a MsgClaimMilestone
+func handleMsgClaimMilestone(ctx sdk.Context, k Keeper, msg MsgClaimMilestone) sdk.Result {
+	program, err := k.GetProgram(ctx, msg.ProgramID)
+	if err != nil {
+		return ErrProgramNotFound(msg.ProgramID).Result()
+	}
+
+	// Only executors can claim the milestones of the program
+	if !program.Executor.Equals(msg.Executor) {
+		return ErrInvalidExecutor(msg.Executor).Result()
+	}
+
+	if msg.Milestone >= uint64(len(program.Milestones)) {
+		return ErrMilestoneNotFound(msg.ProgramID, msg.Milestone).Result()
+	}
+
+	// Milestones are claimed once each, in order
+	if program.Milestones[msg.Milestone].Claimed ||
+		(msg.Milestone > 0 && !program.Milestones[msg.Milestone-1].Approved) {
+		return ErrMilestoneNotClaimable(msg.ProgramID, msg.Milestone).Result()
+	}
+
+	program.Milestones[msg.Milestone].Claimed = true
+	k.StoreProgram(ctx, program)
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.Action, tags.ActionMilestoneClaimed,
+			tags.ProgramID, strconv.FormatUint(msg.ProgramID, 10),
+			tags.Milestone, strconv.FormatUint(msg.Milestone, 10),
+			tags.Executor, msg.Executor.String(),
+		),
+	}
+}
+
+// handleMsgApproveMilestone handles the logic of a MsgApproveMilestone
+func handleMsgApproveMilestone(ctx sdk.Context, k Keeper, msg MsgApproveMilestone) sdk.Result {
+	resTags := sdk.NewTags()
+
+	program, err := k.GetProgram(ctx, msg.ProgramID)
+	if err != nil {
+		return ErrProgramNotFound(msg.ProgramID).Result()
+	}
+
 	// Check the voter is a validator
 	val := k.valset.Validator(ctx, sdk.ValAddress(msg.Voter))
 	if val == nil {
 		return staking.ErrNoDelegatorForAddress(DefaultCodespace).Result()
 	}
 
-	k.AddVote(ctx, msg.ProgramID, msg.Voter, msg.Option)
+	if msg.Milestone >= uint64(len(program.Milestones)) {
+		return ErrMilestoneNotFound(msg.ProgramID, msg.Milestone).Result()
+	}
+
+	milestone := program.Milestones[msg.Milestone]
+	if !milestone.Claimed || milestone.Approved {
+		return ErrMilestoneNotPending(msg.ProgramID, msg.Milestone).Result()
+	}
+
+	k.AddMilestoneApproval(ctx, msg.ProgramID, msg.Milestone, msg.Voter)
+
+	// Unlock the tranche once the approving validators clear the active threshold
+	approvalPower, totalPower := tallyMilestone(ctx, k, msg.ProgramID, msg.Milestone)
+	if clearsThreshold(approvalPower, totalPower, k.GetParams(ctx).ActiveThreshold) {
+		program.Milestones[msg.Milestone].Approved = true
+		k.StoreProgram(ctx, program)
+
+		k.DeleteMilestoneApprovalsForProgram(ctx, msg.ProgramID)
+		resTags = resTags.AppendTag(tags.Action, tags.ActionMilestoneApproved)
+	}
 
 	return sdk.Result{
 		Tags: resTags.AppendTags(
 			sdk.NewTags(
 				tags.ProgramID, strconv.FormatUint(msg.ProgramID, 10),
+				tags.Milestone, strconv.FormatUint(msg.Milestone, 10),
 				tags.Voter, msg.Voter.String(),
-				tags.Option, strconv.FormatBool(msg.Option),
 			),
 		),
 	}
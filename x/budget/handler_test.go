@@ -3,7 +3,18 @@ package budget
 import (
 	"testing"
 
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/mock"
+
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+var (
+	testBudget  = sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	testCeiling = sdk.NewInt(500)
 )
 
 func TestHandlerMsgSubmitProgram(t *testing.T) {
@@ -12,10 +23,29 @@ func TestHandlerMsgSubmitProgram(t *testing.T) {
 	h := NewHandler(input.budgetKeeper)
 
 	// Regular submit msg passes
-	msg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1])
+	msg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, nil)
 	res := h(input.ctx, msg)
 	require.True(t, res.IsOK())
 
+	// The requested budget must be in a denom whitelisted by the oracle
+	msg = NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], sdk.NewInt64Coin("unknown", 1000), testCeiling, nil)
+	res = h(input.ctx, msg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeUnknownDenom, res.Code)
+
+	// The epoch ceiling cannot exceed the requested budget
+	msg = NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, sdk.NewInt(1001), nil)
+	require.Error(t, msg.ValidateBasic())
+
+	// Milestones must add up to the requested budget
+	milestones := Milestones{NewMilestone("first", sdk.NewInt(400)), NewMilestone("second", sdk.NewInt(500))}
+	msg = NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, milestones)
+	require.Error(t, msg.ValidateBasic())
+
+	milestones = Milestones{NewMilestone("first", sdk.NewInt(400)), NewMilestone("second", sdk.NewInt(600))}
+	msg = NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, milestones)
+	require.Nil(t, msg.ValidateBasic())
+
 	// Everything else should be tested in validateMsg ... so skip
 }
 
@@ -25,7 +55,7 @@ func TestHandlerMsgWithdrawProgram(t *testing.T) {
 	h := NewHandler(input.budgetKeeper)
 
 	// Submit program
-	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1])
+	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, nil)
 	res := h(input.ctx, submitMsg)
 	require.True(t, res.IsOK())
 
@@ -48,6 +78,21 @@ func TestHandlerMsgWithdrawProgram(t *testing.T) {
 	withdrawMsg = NewMsgWithdrawProgram(4, addrs[2])
 	res = h(input.ctx, withdrawMsg)
 	require.False(t, res.IsOK())
+
+	// Withdrawing a vetoed program doesn't work
+	res = h(input.ctx, submitMsg)
+	require.True(t, res.IsOK())
+
+	input.budgetKeeper.AddVote(input.ctx, 2, addrs[1], OptionNoWithVeto)
+	noStakeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	input.budgetKeeper.AddVote(input.ctx, 2, noStakeAddr, OptionYes)
+	withdrawMsg = NewMsgWithdrawProgram(2, addrs[0])
+	res = h(input.ctx, withdrawMsg)
+	require.Equal(t, CodeProgramVetoed, res.Code)
+
+	// The veto check leaves the votes of accounts without stake to the EndBlocker
+	_, err := input.budgetKeeper.GetVote(input.ctx, 2, noStakeAddr)
+	require.Nil(t, err)
 }
 
 func TestHandlerMsgVoteCandidate(t *testing.T) {
@@ -56,17 +101,146 @@ func TestHandlerMsgVoteCandidate(t *testing.T) {
 	h := NewHandler(input.budgetKeeper)
 
 	// Submit program
-	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1])
+	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, nil)
 	res := h(input.ctx, submitMsg)
 	require.True(t, res.IsOK())
 
 	// Voting on a submitted program works
-	voteMsg := NewMsgVoteProgram(1, true, addrs[0])
+	voteMsg := NewMsgVoteProgram(1, OptionYes, addrs[0])
 	res = h(input.ctx, voteMsg)
 	require.True(t, res.IsOK())
 
 	// Voting on an un submitted program doesn't work
-	voteMsg = NewMsgVoteProgram(4, true, addrs[0])
+	voteMsg = NewMsgVoteProgram(4, OptionYes, addrs[0])
+	res = h(input.ctx, voteMsg)
+	require.False(t, res.IsOK())
+
+	// Abstaining and vetoing work too
+	voteMsg = NewMsgVoteProgram(1, OptionAbstain, addrs[0])
+	res = h(input.ctx, voteMsg)
+	require.True(t, res.IsOK())
+
+	voteMsg = NewMsgVoteProgram(1, OptionNoWithVeto, addrs[0])
+	res = h(input.ctx, voteMsg)
+	require.True(t, res.IsOK())
+
+	option, err := input.budgetKeeper.GetVote(input.ctx, 1, addrs[0])
+	require.Nil(t, err)
+	require.Equal(t, OptionNoWithVeto, option)
+}
+
+func TestHandlerMsgVoteDelegator(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.budgetKeeper)
+
+	// Submit program
+	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, nil)
+	res := h(input.ctx, submitMsg)
+	require.True(t, res.IsOK())
+
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// Accounts without delegations can't vote
+	voteMsg := NewMsgVoteProgram(1, OptionYes, delegator)
 	res = h(input.ctx, voteMsg)
 	require.False(t, res.IsOK())
+
+	// Delegators of a validator can vote
+	ds := mock.NewMockDelegationSet()
+	ds.Delegations = append(ds.Delegations, mock.NewMockDelegation(delegator, sdk.ValAddress(addrs[0]), sdk.NewDec(10)))
+	input.budgetKeeper.ds = ds
+
+	res = h(input.ctx, voteMsg)
+	require.True(t, res.IsOK())
+
+	option, err := input.budgetKeeper.GetVote(input.ctx, 1, delegator)
+	require.Nil(t, err)
+	require.Equal(t, OptionYes, option)
+}
+
+func TestHandlerMsgClaimMilestone(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.budgetKeeper)
+
+	// Submit program with two milestones
+	milestones := Milestones{NewMilestone("first", sdk.NewInt(400)), NewMilestone("second", sdk.NewInt(600))}
+	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, milestones)
+	res := h(input.ctx, submitMsg)
+	require.True(t, res.IsOK())
+
+	// Only the executor can claim a milestone
+	claimMsg := NewMsgClaimMilestone(1, 0, addrs[0])
+	res = h(input.ctx, claimMsg)
+	require.False(t, res.IsOK())
+
+	// Milestones are claimed in order
+	claimMsg = NewMsgClaimMilestone(1, 1, addrs[1])
+	res = h(input.ctx, claimMsg)
+	require.False(t, res.IsOK())
+
+	// Claiming the first milestone works, but only once
+	claimMsg = NewMsgClaimMilestone(1, 0, addrs[1])
+	res = h(input.ctx, claimMsg)
+	require.True(t, res.IsOK())
+
+	res = h(input.ctx, claimMsg)
+	require.False(t, res.IsOK())
+
+	program, err := input.budgetKeeper.GetProgram(input.ctx, 1)
+	require.Nil(t, err)
+	require.True(t, program.Milestones[0].Claimed)
+	require.False(t, program.Milestones[0].Approved)
+
+	// Claiming a missing milestone doesn't work
+	claimMsg = NewMsgClaimMilestone(1, 2, addrs[1])
+	res = h(input.ctx, claimMsg)
+	require.False(t, res.IsOK())
+}
+
+func TestHandlerMsgApproveMilestone(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.budgetKeeper)
+
+	// Submit program with two milestones
+	milestones := Milestones{NewMilestone("first", sdk.NewInt(400)), NewMilestone("second", sdk.NewInt(600))}
+	submitMsg := NewMsgSubmitProgram("test", "testdescription", addrs[0], addrs[1], testBudget, testCeiling, milestones)
+	res := h(input.ctx, submitMsg)
+	require.True(t, res.IsOK())
+
+	// Approving an unclaimed milestone doesn't work
+	approveMsg := NewMsgApproveMilestone(1, 0, addrs[0])
+	res = h(input.ctx, approveMsg)
+	require.False(t, res.IsOK())
+
+	claimMsg := NewMsgClaimMilestone(1, 0, addrs[1])
+	res = h(input.ctx, claimMsg)
+	require.True(t, res.IsOK())
+
+	// Only validators can approve
+	approveMsg = NewMsgApproveMilestone(1, 0, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()))
+	res = h(input.ctx, approveMsg)
+	require.False(t, res.IsOK())
+
+	// A third of the bonded tokens clears the active threshold
+	approveMsg = NewMsgApproveMilestone(1, 0, addrs[0])
+	res = h(input.ctx, approveMsg)
+	require.True(t, res.IsOK())
+
+	program, err := input.budgetKeeper.GetProgram(input.ctx, 1)
+	require.Nil(t, err)
+	require.True(t, program.Milestones[0].Approved)
+	require.Equal(t, sdk.NewInt(400), program.unlockedBudget())
+
+	// The approved milestone is no longer awaiting approval
+	approveMsg = NewMsgApproveMilestone(1, 0, addrs[2])
+	res = h(input.ctx, approveMsg)
+	require.False(t, res.IsOK())
+
+	// The next milestone can now be claimed
+	claimMsg = NewMsgClaimMilestone(1, 1, addrs[1])
+	res = h(input.ctx, claimMsg)
+	require.True(t, res.IsOK())
 }
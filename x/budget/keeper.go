@@ -4,8 +4,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/terra-project/core/types"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -13,13 +11,16 @@ import (
 
 // nolint
 type Keeper struct {
-	cdc    *codec.Codec     // Codec to encore/decode structs
-	key    sdk.StoreKey     // Key to our module's store
-	valset sdk.ValidatorSet // Needed to compute voting power.
+	cdc    *codec.Codec      // Codec to encore/decode structs
+	key    sdk.StoreKey      // Key to our module's store
+	valset sdk.ValidatorSet  // Needed to compute voting power.
+	ds     sdk.DelegationSet // Needed to compute the voting power of delegators.
 
-	mrk        MarketKeeper   // Needed to handle claims. This module only requires read swap rate between SDR and LUNA
+	mrk        MarketKeeper   // Needed to handle claims. This module only requires read swap rates to value program shares
+	ok         OracleKeeper   // Needed to check that requested budgets are in a denom whitelisted by the oracle
 	mk         MintKeeper     // Needed to handle deposits. This module only requires read/writes to Terra balance and read seigniorage
 	tk         TreasuryKeeper // Needed to handle claims. This module only requires read current reward weight
+	ck         CalendarKeeper // Needed to find epoch boundaries for the reward distribution
 	paramSpace params.Subspace
 }
 
@@ -27,17 +28,23 @@ type Keeper struct {
 func NewKeeper(cdc *codec.Codec,
 	key sdk.StoreKey,
 	mrk MarketKeeper,
+	ok OracleKeeper,
 	mk MintKeeper,
 	tk TreasuryKeeper,
 	valset sdk.ValidatorSet,
+	ds sdk.DelegationSet,
+	ck CalendarKeeper,
 	paramspace params.Subspace) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		key:        key,
 		mrk:        mrk,
+		ok:         ok,
 		mk:         mk,
 		tk:         tk,
 		valset:     valset,
+		ds:         ds,
+		ck:         ck,
 		paramSpace: paramspace.WithKeyTable(paramKeyTable()),
 	}
 }
@@ -46,10 +53,11 @@ func NewKeeper(cdc *codec.Codec,
 // Vote logic
 
 // GetVote returns the given option of a Program stored in the keeper
-func (k Keeper) GetVote(ctx sdk.Context, programID uint64, voter sdk.AccAddress) (res bool, err sdk.Error) {
+func (k Keeper) GetVote(ctx sdk.Context, programID uint64, voter sdk.AccAddress) (res VoteOption, err sdk.Error) {
 	store := ctx.KVStore(k.key)
 	if bz := store.Get(keyVote(programID, voter)); bz != nil {
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+		res = migrateVoteOption(res)
 	} else {
 		err = ErrVoteNotFound()
 	}
@@ -57,7 +65,7 @@ func (k Keeper) GetVote(ctx sdk.Context, programID uint64, voter sdk.AccAddress)
 }
 
 // AddVote adds the vote option to the store
-func (k Keeper) AddVote(ctx sdk.Context, programID uint64, voter sdk.AccAddress, option bool) {
+func (k Keeper) AddVote(ctx sdk.Context, programID uint64, voter sdk.AccAddress, option VoteOption) {
 	store := ctx.KVStore(k.key)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(option)
 	store.Set(keyVote(programID, voter), bz)
@@ -80,19 +88,20 @@ func (k Keeper) DeleteVotesForProgram(ctx sdk.Context, programID uint64) {
 }
 
 // IterateVotes iterates votes in the store
-func (k Keeper) IterateVotes(ctx sdk.Context, handler func(uint64, sdk.AccAddress, bool) (stop bool)) {
+func (k Keeper) IterateVotes(ctx sdk.Context, handler func(uint64, sdk.AccAddress, VoteOption) (stop bool)) {
 	k.IterateVotesWithPrefix(ctx, prefixVote, handler)
 }
 
 // IterateVotesWithPrefix iterates votes with given {prefix} in the store
-func (k Keeper) IterateVotesWithPrefix(ctx sdk.Context, prefix []byte, handler func(uint64, sdk.AccAddress, bool) (stop bool)) {
+func (k Keeper) IterateVotesWithPrefix(ctx sdk.Context, prefix []byte, handler func(uint64, sdk.AccAddress, VoteOption) (stop bool)) {
 	store := ctx.KVStore(k.key)
 	iter := sdk.KVStorePrefixIterator(store, prefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		voteKey := string(iter.Key())
-		var option bool
+		var option VoteOption
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &option)
+		option = migrateVoteOption(option)
 
 		elems := strings.Split(voteKey, ":")
 		programID, err := strconv.ParseUint(elems[1], 10, 0)
@@ -112,6 +121,19 @@ func (k Keeper) IterateVotesWithPrefix(ctx sdk.Context, prefix []byte, handler f
 	}
 }
 
+// isStaker returns true if the account is a validator or delegates to a validator
+func (k Keeper) isStaker(ctx sdk.Context, addr sdk.AccAddress) (res bool) {
+	if k.valset.Validator(ctx, sdk.ValAddress(addr)) != nil {
+		return true
+	}
+
+	k.ds.IterateDelegations(ctx, addr, func(_ int64, delegation sdk.Delegation) (stop bool) {
+		res = k.valset.Validator(ctx, delegation.GetValidatorAddr()) != nil
+		return res
+	})
+	return
+}
+
 //-----------------------------------
 // Deposit logic
 
@@ -187,6 +209,13 @@ func (k Keeper) DeleteProgram(ctx sdk.Context, programID uint64) {
 	store.Delete(keyProgram(programID))
 }
 
+// deleteProgramAndVotes deletes a program with all the votes and milestone approvals on it
+func (k Keeper) deleteProgramAndVotes(ctx sdk.Context, programID uint64) {
+	k.DeleteVotesForProgram(ctx, programID)
+	k.DeleteMilestoneApprovalsForProgram(ctx, programID)
+	k.DeleteProgram(ctx, programID)
+}
+
 // IteratePrograms iterates programs in the store
 func (k Keeper) IteratePrograms(ctx sdk.Context, filterInactive bool, handler func(Program) (stop bool)) {
 	store := ctx.KVStore(k.key)
@@ -262,50 +291,121 @@ func (k Keeper) CandQueueRemove(ctx sdk.Context, endBlock int64, programID uint6
 	store.Delete(keyCandidate(endBlock, programID))
 }
 
+//-----------------------------------
+// Milestone approval logic
+
+// AddMilestoneApproval records the approval of a claimed milestone by a validator
+func (k Keeper) AddMilestoneApproval(ctx sdk.Context, programID uint64, milestone uint64, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(voter)
+	store.Set(keyMilestoneApproval(programID, milestone, voter), bz)
+}
+
+// IterateMilestoneApprovals iterates the validators that approved the given milestone of a program
+func (k Keeper) IterateMilestoneApprovals(ctx sdk.Context, programID uint64, milestone uint64, handler func(voter sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, keyMilestoneApproval(programID, milestone, sdk.AccAddress{}))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var voter sdk.AccAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &voter)
+
+		if handler(voter) {
+			break
+		}
+	}
+}
+
+// IterateAllMilestoneApprovals iterates the approvals of every milestone of every program
+func (k Keeper) IterateAllMilestoneApprovals(ctx sdk.Context, handler func(programID uint64, milestone uint64, voter sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixMilestoneApproval)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// Key format is milestone-approval:{programID}:{milestone}:{voter}
+		parts := strings.Split(string(iter.Key()), ":")
+		if len(parts) != 4 {
+			continue
+		}
+
+		programID, err := strconv.ParseUint(parts[1], 10, 0)
+		if err != nil {
+			continue
+		}
+
+		milestone, err := strconv.ParseUint(parts[2], 10, 0)
+		if err != nil {
+			continue
+		}
+
+		var voter sdk.AccAddress
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &voter)
+
+		if handler(programID, milestone, voter) {
+			break
+		}
+	}
+}
+
+// DeleteMilestoneApproval deletes the approval of a milestone by a validator from the store
+func (k Keeper) DeleteMilestoneApproval(ctx sdk.Context, programID uint64, milestone uint64, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(keyMilestoneApproval(programID, milestone, voter))
+}
+
+// DeleteMilestoneApprovalsForProgram deletes the milestone approvals for the program from the store
+func (k Keeper) DeleteMilestoneApprovalsForProgram(ctx sdk.Context, programID uint64) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixMilestoneApprovalProgram(programID))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
 //-----------------------------------
 // Claim pool logic
 
-// Iterate over oracle reward claims in the store
-func (k Keeper) iterateClaimPool(ctx sdk.Context, handler func(recipient sdk.AccAddress, weight sdk.Int) (stop bool)) {
+// Iterate over program reward claims in the store
+func (k Keeper) iterateClaimPool(ctx sdk.Context, handler func(programID uint64, weight sdk.Int) (stop bool)) {
 	store := ctx.KVStore(k.key)
 	iter := sdk.KVStorePrefixIterator(store, prefixClaim)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		recipientAddress := strings.Split(string(iter.Key()), ":")[1]
-		recipient, _ := sdk.AccAddressFromBech32(recipientAddress)
+		programID, err := strconv.ParseUint(strings.Split(string(iter.Key()), ":")[1], 10, 0)
+		if err != nil {
+			continue
+		}
 
 		var weight sdk.Int
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &weight)
-		if handler(recipient, weight) {
+		if handler(programID, weight) {
 			break
 		}
 	}
 }
 
-// addClaimPool adds a claim to the the claim pool in the store
-func (k Keeper) addClaimPool(ctx sdk.Context, pool types.ClaimPool) {
+// addClaim adds the weight of a program to its claim in the claim pool in the store
+func (k Keeper) addClaim(ctx sdk.Context, programID uint64, weight sdk.Int) {
 	store := ctx.KVStore(k.key)
 
-	for _, claim := range pool {
-		storeKeyClaim := keyClaim(claim.Recipient)
-		b := store.Get(storeKeyClaim)
-		weight := claim.Weight
-		if b != nil {
-			var prevWeight sdk.Int
-			k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &prevWeight)
+	storeKeyClaim := keyClaim(programID)
+	if b := store.Get(storeKeyClaim); b != nil {
+		var prevWeight sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &prevWeight)
 
-			weight = weight.Add(prevWeight)
-		}
-		b = k.cdc.MustMarshalBinaryLengthPrefixed(weight)
-		store.Set(storeKeyClaim, b)
+		weight = weight.Add(prevWeight)
 	}
+
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(weight)
+	store.Set(storeKeyClaim, b)
 }
 
 // clearClaimPool clears the claim pool from the store
 func (k Keeper) clearClaimPool(ctx sdk.Context) {
 	store := ctx.KVStore(k.key)
-	k.iterateClaimPool(ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
-		store.Delete(keyClaim(recipient))
+	k.iterateClaimPool(ctx, func(programID uint64, weight sdk.Int) (stop bool) {
+		store.Delete(keyClaim(programID))
 		return false
 	})
 }
@@ -17,6 +17,8 @@ var (
 	prefixCandQueue = []byte("candidate-queue")
 	prefixClaim     = []byte("claim")
 
+	prefixMilestoneApproval = []byte("milestone-approval")
+
 	paramStoreKeyParams = []byte("params")
 )
 
@@ -36,8 +38,16 @@ func keyCandidate(endBlock int64, programID uint64) []byte {
 	return []byte(fmt.Sprintf("%s:%020d:%d", prefixCandQueue, endBlock, programID))
 }
 
-func keyClaim(recipient sdk.AccAddress) []byte {
-	return []byte(fmt.Sprintf("%s:%s", prefixClaim, recipient))
+func keyClaim(programID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%d", prefixClaim, programID))
+}
+
+func prefixMilestoneApprovalProgram(programID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%d:", prefixMilestoneApproval, programID))
+}
+
+func keyMilestoneApproval(programID uint64, milestone uint64, voterAddr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s:%d:%d:%s", prefixMilestoneApproval, programID, milestone, voterAddr))
 }
 
 func paramKeyTable() params.KeyTable {
@@ -8,7 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
-	"github.com/terra-project/core/types"
+	"github.com/terra-project/core/types/assets"
 )
 
 func TestKeeperProgramID(t *testing.T) {
@@ -74,7 +74,8 @@ func TestKeeperProgram(t *testing.T) {
 	numTests := rand.Int() % maxTests
 	for i := 0; i < numTests; i++ {
 		programID := uint64(rand.Int63() % int64(idCeiling))
-		testProgram := NewProgram(programID, "", "", addrs[0], addrs[1], 0)
+		testProgram := NewProgram(programID, "", "", addrs[0], addrs[1], 0,
+			sdk.NewInt64Coin(assets.MicroLunaDenom, 1000), sdk.NewInt(100), nil)
 		action := rand.Int() % 2
 		if action == 0 {
 			programBitmap[programID] = true
@@ -138,7 +139,7 @@ func TestKeeperVote(t *testing.T) {
 		action := rand.Int() % 2
 		if action == 0 {
 			voteBitmap[programID][voterAddress.String()] = true
-			input.budgetKeeper.AddVote(input.ctx, programID, voterAddress, true)
+			input.budgetKeeper.AddVote(input.ctx, programID, voterAddress, OptionYes)
 		} else {
 			voteBitmap[programID][voterAddress.String()] = false
 			input.budgetKeeper.DeleteVote(input.ctx, programID, voterAddress)
@@ -164,7 +165,7 @@ func TestKeeperVote(t *testing.T) {
 	// Match live programs in the store
 	actualLiveVoteCount := 0
 	input.budgetKeeper.IterateVotes(input.ctx,
-		func(programID uint64, voterAddress sdk.AccAddress, option bool) (stop bool) {
+		func(programID uint64, voterAddress sdk.AccAddress, option VoteOption) (stop bool) {
 			require.True(t, voteBitmap[programID][voterAddress.String()])
 			actualLiveVoteCount++
 			return false
@@ -174,6 +175,36 @@ func TestKeeperVote(t *testing.T) {
 	require.Equal(t, expectedLiveVoteCount, actualLiveVoteCount)
 }
 
+func TestKeeperLegacyVotes(t *testing.T) {
+	input := createTestInput(t)
+
+	// Votes stored before options were enumerated are bools
+	input.budgetKeeper.AddVote(input.ctx, 1, addrs[0], OptionYes)
+	store := input.ctx.KVStore(input.budgetKeeper.key)
+	store.Set(keyVote(1, addrs[1]), input.budgetKeeper.cdc.MustMarshalBinaryLengthPrefixed(true))
+	store.Set(keyVote(1, addrs[2]), input.budgetKeeper.cdc.MustMarshalBinaryLengthPrefixed(false))
+
+	option, err := input.budgetKeeper.GetVote(input.ctx, 1, addrs[1])
+	require.Nil(t, err)
+	require.Equal(t, OptionYes, option)
+
+	option, err = input.budgetKeeper.GetVote(input.ctx, 1, addrs[2])
+	require.Nil(t, err)
+	require.Equal(t, OptionNo, option)
+
+	options := map[string]VoteOption{}
+	input.budgetKeeper.IterateVotes(input.ctx, func(programID uint64, voter sdk.AccAddress, option VoteOption) (stop bool) {
+		options[voter.String()] = option
+		return false
+	})
+
+	require.Equal(t, map[string]VoteOption{
+		addrs[0].String(): OptionYes,
+		addrs[1].String(): OptionYes,
+		addrs[2].String(): OptionNo,
+	}, options)
+}
+
 func TestKeeperCandidateQueue(t *testing.T) {
 	input := createTestInput(t)
 
@@ -219,26 +250,60 @@ func TestKeeperCandidateQueue(t *testing.T) {
 func TestKeeperClaimPool(t *testing.T) {
 	input := createTestInput(t)
 
-	// Test addClaimPool
-	claim := types.NewClaim(sdk.NewInt(10), addrs[0])
-	claim2 := types.NewClaim(sdk.NewInt(20), addrs[1])
-	claimPool := types.ClaimPool{claim, claim2}
-	input.budgetKeeper.addClaimPool(input.ctx, claimPool)
+	// Test addClaim
+	input.budgetKeeper.addClaim(input.ctx, 1, sdk.NewInt(10))
+	input.budgetKeeper.addClaim(input.ctx, 2, sdk.NewInt(20))
 
-	claim = types.NewClaim(sdk.NewInt(15), addrs[0])
-	claim2 = types.NewClaim(sdk.NewInt(30), addrs[2])
-	claimPool = types.ClaimPool{claim, claim2}
-	input.budgetKeeper.addClaimPool(input.ctx, claimPool)
+	input.budgetKeeper.addClaim(input.ctx, 1, sdk.NewInt(15))
+	input.budgetKeeper.addClaim(input.ctx, 3, sdk.NewInt(30))
 
 	// Test iterateClaimPool
-	input.budgetKeeper.iterateClaimPool(input.ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
-		if recipient.Equals(addrs[0]) {
+	claimCount := 0
+	input.budgetKeeper.iterateClaimPool(input.ctx, func(programID uint64, weight sdk.Int) (stop bool) {
+		switch programID {
+		case 1:
 			require.Equal(t, sdk.NewInt(25), weight)
-		} else if recipient.Equals(addrs[1]) {
+		case 2:
 			require.Equal(t, sdk.NewInt(20), weight)
-		} else if recipient.Equals(addrs[2]) {
+		case 3:
 			require.Equal(t, sdk.NewInt(30), weight)
 		}
+		claimCount++
 		return false
 	})
+	require.Equal(t, 3, claimCount)
+
+	// Test clearClaimPool
+	input.budgetKeeper.clearClaimPool(input.ctx)
+	require.Equal(t, 0, countClaimPool(input.ctx, input.budgetKeeper))
+}
+
+func TestKeeperMilestoneApproval(t *testing.T) {
+	input := createTestInput(t)
+
+	input.budgetKeeper.AddMilestoneApproval(input.ctx, 1, 0, addrs[0])
+	input.budgetKeeper.AddMilestoneApproval(input.ctx, 1, 0, addrs[1])
+	input.budgetKeeper.AddMilestoneApproval(input.ctx, 1, 1, addrs[2])
+	input.budgetKeeper.AddMilestoneApproval(input.ctx, 10, 0, addrs[2])
+
+	countApprovals := func(programID uint64, milestone uint64) (count int) {
+		input.budgetKeeper.IterateMilestoneApprovals(input.ctx, programID, milestone, func(voter sdk.AccAddress) (stop bool) {
+			count++
+			return false
+		})
+		return
+	}
+
+	require.Equal(t, 2, countApprovals(1, 0))
+	require.Equal(t, 1, countApprovals(1, 1))
+	require.Equal(t, 1, countApprovals(10, 0))
+
+	input.budgetKeeper.DeleteMilestoneApproval(input.ctx, 1, 0, addrs[0])
+	require.Equal(t, 1, countApprovals(1, 0))
+
+	// Deleting the approvals of program 1 leaves program 10 alone
+	input.budgetKeeper.DeleteMilestoneApprovalsForProgram(input.ctx, 1)
+	require.Equal(t, 0, countApprovals(1, 0))
+	require.Equal(t, 0, countApprovals(1, 1))
+	require.Equal(t, 1, countApprovals(10, 0))
 }
@@ -0,0 +1,78 @@
+package budget
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Milestone is a tranche of the requested budget of a Program. The executor claims it once reached,
+// and the tranche is unlocked for payment when validators approve the claim.
+type Milestone struct {
+	Title    string  `json:"title"`    // Title of the Milestone
+	Amount   sdk.Int `json:"amount"`   // Tranche of the requested budget, in its denom
+	Claimed  bool    `json:"claimed"`  // Whether the executor claimed the Milestone
+	Approved bool    `json:"approved"` // Whether validators approved the claim
+}
+
+// NewMilestone creates a Milestone instance
+func NewMilestone(title string, amount sdk.Int) Milestone {
+	return Milestone{
+		Title:  title,
+		Amount: amount,
+	}
+}
+
+// String implements fmt.Stringer
+func (m Milestone) String() string {
+	return fmt.Sprintf(`Milestone
+	Title: %s
+	Amount: %s
+	Claimed: %v
+	Approved: %v`, m.Title, m.Amount, m.Claimed, m.Approved)
+}
+
+// Milestones is a collection of Milestone
+type Milestones []Milestone
+
+func (m Milestones) String() (out string) {
+	for _, val := range m {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// MilestoneApproval is the approval of a claimed Milestone by a validator
+type MilestoneApproval struct {
+	ProgramID uint64         `json:"program_id"` // ID of the Program
+	Milestone uint64         `json:"milestone"`  // Index of the Milestone in the Program
+	Voter     sdk.AccAddress `json:"voter"`      // Address of the approving validator
+}
+
+// NewMilestoneApproval creates a MilestoneApproval instance
+func NewMilestoneApproval(programID uint64, milestone uint64, voter sdk.AccAddress) MilestoneApproval {
+	return MilestoneApproval{
+		ProgramID: programID,
+		Milestone: milestone,
+		Voter:     voter,
+	}
+}
+
+// String implements fmt.Stringer
+func (a MilestoneApproval) String() string {
+	return fmt.Sprintf(`MilestoneApproval
+	ProgramID: %d
+	Milestone: %d
+	Voter: %s`, a.ProgramID, a.Milestone, a.Voter)
+}
+
+// MilestoneApprovals is a collection of MilestoneApproval
+type MilestoneApprovals []MilestoneApproval
+
+func (a MilestoneApprovals) String() (out string) {
+	for _, val := range a {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
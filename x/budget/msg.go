@@ -13,16 +13,24 @@ type MsgSubmitProgram struct {
 	Description string         `json:"description"` // Description of the Program
 	Submitter   sdk.AccAddress `json:"submitter"`   // Address of the submitter
 	Executor    sdk.AccAddress `json:"executor"`    // Address of the executor
+
+	RequestedBudget sdk.Coin   `json:"requested_budget"` // Total budget requested by the Program
+	EpochCeiling    sdk.Int    `json:"epoch_ceiling"`    // Maximum amount of the requested budget paid in an epoch
+	Milestones      Milestones `json:"milestones"`       // Optional tranches of the requested budget
 }
 
 // NewMsgSubmitProgram submits a message with a new Program
 func NewMsgSubmitProgram(title string, description string,
-	submitter sdk.AccAddress, executor sdk.AccAddress) MsgSubmitProgram {
+	submitter sdk.AccAddress, executor sdk.AccAddress,
+	requestedBudget sdk.Coin, epochCeiling sdk.Int, milestones Milestones) MsgSubmitProgram {
 	return MsgSubmitProgram{
-		Title:       title,
-		Description: description,
-		Submitter:   submitter,
-		Executor:    executor,
+		Title:           title,
+		Description:     description,
+		Submitter:       submitter,
+		Executor:        executor,
+		RequestedBudget: requestedBudget,
+		EpochCeiling:    epochCeiling,
+		Milestones:      milestones,
 	}
 }
 
@@ -57,7 +65,7 @@ func (msg MsgSubmitProgram) ValidateBasic() sdk.Error {
 		return ErrInvalidDescription()
 	}
 
-	return nil
+	return validateBudget(msg.RequestedBudget, msg.EpochCeiling, msg.Milestones)
 }
 
 // String stringify the msg
@@ -65,7 +73,10 @@ func (msg MsgSubmitProgram) String() string {
 	return fmt.Sprintf(`MsgSubmitProgram
 	Title: %v
 	Submitter: %v
-	Executor: %v`, msg.Title, msg.Submitter, msg.Executor)
+	Executor: %v
+	RequestedBudget: %v
+	EpochCeiling: %v
+	Milestones: %v`, msg.Title, msg.Submitter, msg.Executor, msg.RequestedBudget, msg.EpochCeiling, msg.Milestones)
 }
 
 //--------------------------------------------------------
@@ -124,12 +135,12 @@ func (msg MsgWithdrawProgram) String() string {
 // specific Program
 type MsgVoteProgram struct {
 	ProgramID uint64         `json:"program_id"` // ID of the Program
-	Option    bool           `json:"option"`     // Option chosen by voter
+	Option    VoteOption     `json:"option"`     // Option chosen by voter
 	Voter     sdk.AccAddress `json:"voter"`      // Address of the voter
 }
 
 // NewMsgVoteProgram creates a MsgVoteProgram instance
-func NewMsgVoteProgram(programID uint64, option bool, voter sdk.AccAddress) MsgVoteProgram {
+func NewMsgVoteProgram(programID uint64, option VoteOption, voter sdk.AccAddress) MsgVoteProgram {
 	return MsgVoteProgram{
 		ProgramID: programID,
 		Option:    option,
@@ -161,6 +172,9 @@ func (msg MsgVoteProgram) ValidateBasic() sdk.Error {
 	if msg.ProgramID == 0 {
 		return ErrInvalidProgramID(msg.ProgramID)
 	}
+	if !ValidVoteOption(msg.Option) {
+		return ErrInvalidOption(msg.Option)
+	}
 
 	return nil
 }
@@ -170,5 +184,117 @@ func (msg MsgVoteProgram) String() string {
 	return fmt.Sprintf(`MsgVoteProgram
 	ProgramID: %v
 	Voter: %v
-	Option: %v`, msg.ProgramID, msg.Voter, msg.Option)
+	Option: %s`, msg.ProgramID, msg.Voter, msg.Option)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgClaimMilestone defines the msg of an executor claiming that a milestone of
+// its Program is reached
+type MsgClaimMilestone struct {
+	ProgramID uint64         `json:"program_id"` // ID of the Program
+	Milestone uint64         `json:"milestone"`  // Index of the claimed milestone
+	Executor  sdk.AccAddress `json:"executor"`   // Address of the executor
+}
+
+// NewMsgClaimMilestone creates a MsgClaimMilestone instance
+func NewMsgClaimMilestone(programID uint64, milestone uint64, executor sdk.AccAddress) MsgClaimMilestone {
+	return MsgClaimMilestone{
+		ProgramID: programID,
+		Milestone: milestone,
+		Executor:  executor,
+	}
+}
+
+// Route returns msg route
+func (msg MsgClaimMilestone) Route() string { return "budget" }
+
+// Type returns msg type
+func (msg MsgClaimMilestone) Type() string { return "claimmilestone" }
+
+// GetSignBytes returns sign byptes
+func (msg MsgClaimMilestone) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns signer
+func (msg MsgClaimMilestone) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Executor}
+}
+
+// ValidateBasic validate msg
+func (msg MsgClaimMilestone) ValidateBasic() sdk.Error {
+	if len(msg.Executor) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Executor.String())
+	}
+	if msg.ProgramID == 0 {
+		return ErrInvalidProgramID(msg.ProgramID)
+	}
+
+	return nil
+}
+
+// String stringify the msg
+func (msg MsgClaimMilestone) String() string {
+	return fmt.Sprintf(`MsgClaimMilestone
+	ProgramID: %v
+	Milestone: %v
+	Executor: %v`, msg.ProgramID, msg.Milestone, msg.Executor)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgApproveMilestone defines the msg of a validator approving a claimed milestone
+// of a Program
+type MsgApproveMilestone struct {
+	ProgramID uint64         `json:"program_id"` // ID of the Program
+	Milestone uint64         `json:"milestone"`  // Index of the approved milestone
+	Voter     sdk.AccAddress `json:"voter"`      // Address of the voter
+}
+
+// NewMsgApproveMilestone creates a MsgApproveMilestone instance
+func NewMsgApproveMilestone(programID uint64, milestone uint64, voter sdk.AccAddress) MsgApproveMilestone {
+	return MsgApproveMilestone{
+		ProgramID: programID,
+		Milestone: milestone,
+		Voter:     voter,
+	}
+}
+
+// Route returns msg route
+func (msg MsgApproveMilestone) Route() string { return "budget" }
+
+// Type returns msg type
+func (msg MsgApproveMilestone) Type() string { return "approvemilestone" }
+
+// GetSignBytes returns sign byptes
+func (msg MsgApproveMilestone) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns signer
+func (msg MsgApproveMilestone) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// ValidateBasic validate msg
+func (msg MsgApproveMilestone) ValidateBasic() sdk.Error {
+	if len(msg.Voter) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Voter.String())
+	}
+	if msg.ProgramID == 0 {
+		return ErrInvalidProgramID(msg.ProgramID)
+	}
+
+	return nil
+}
+
+// String stringify the msg
+func (msg MsgApproveMilestone) String() string {
+	return fmt.Sprintf(`MsgApproveMilestone
+	ProgramID: %v
+	Milestone: %v
+	Voter: %v`, msg.ProgramID, msg.Milestone, msg.Voter)
 }
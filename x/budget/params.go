@@ -13,15 +13,19 @@ import (
 type Params struct {
 	ActiveThreshold sdk.Dec  `json:"active_threshold"` // threshold of vote that will transition a program open -> active budget queue
 	LegacyThreshold sdk.Dec  `json:"legacy_threshold"` // threshold of vote that will transition a program active -> legacy budget queue
+	Quorum          sdk.Dec  `json:"quorum"`           // minimum share of the total voting power that must vote on an open program
+	VetoThreshold   sdk.Dec  `json:"veto_threshold"`   // share of the participating voting power voting no with veto above which a program is vetoed
 	VotePeriod      int64    `json:"vote_period"`      // vote period
 	Deposit         sdk.Coin `json:"deposit"`          // Minimum deposit in TerraSDR
 }
 
 // NewParams creates a new param instance
-func NewParams(activeThreshold sdk.Dec, legacyThreshold sdk.Dec, votePeriod int64, deposit sdk.Coin) Params {
+func NewParams(activeThreshold sdk.Dec, legacyThreshold sdk.Dec, quorum sdk.Dec, vetoThreshold sdk.Dec, votePeriod int64, deposit sdk.Coin) Params {
 	return Params{
 		ActiveThreshold: activeThreshold,
 		LegacyThreshold: legacyThreshold,
+		Quorum:          quorum,
+		VetoThreshold:   vetoThreshold,
 		VotePeriod:      votePeriod,
 		Deposit:         deposit,
 	}
@@ -30,8 +34,10 @@ func NewParams(activeThreshold sdk.Dec, legacyThreshold sdk.Dec, votePeriod int6
 // DefaultParams creates default budget module parameters
 func DefaultParams() Params {
 	return NewParams(
-		sdk.NewDecWithPrec(1, 1), // 10%
-		sdk.NewDecWithPrec(0, 2), // 0%
+		sdk.NewDecWithPrec(1, 1),   // 10%
+		sdk.NewDecWithPrec(0, 2),   // 0%
+		sdk.NewDecWithPrec(1, 1),   // 10%
+		sdk.NewDecWithPrec(334, 3), // 33.4%
 		util.BlocksPerMonth,
 		sdk.NewInt64Coin(assets.MicroSDRDenom, sdk.NewInt(100).MulRaw(assets.MicroUnit).Int64()),
 	)
@@ -44,6 +50,12 @@ func validateParams(params Params) error {
 	if params.LegacyThreshold.LT(sdk.ZeroDec()) {
 		return fmt.Errorf("budget legacy threshold should be greater than or equal to 0, is %s", params.LegacyThreshold.String())
 	}
+	if params.Quorum.LT(sdk.ZeroDec()) || params.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("budget quorum should be between 0 and 1, is %s", params.Quorum.String())
+	}
+	if params.VetoThreshold.LTE(sdk.ZeroDec()) || params.VetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("budget veto threshold should be greater than 0 and at most 1, is %s", params.VetoThreshold.String())
+	}
 	if params.VotePeriod <= 0 {
 		return fmt.Errorf("budget parameter VotePeriod must be > 0, is %d", params.VotePeriod)
 	}
@@ -57,7 +69,9 @@ func (params Params) String() string {
 	return fmt.Sprintf(`Budget Params:
 	ActiveThreshold: %s
 	LegacyThreshold: %s
+	Quorum: %s
+	VetoThreshold: %s
 	VotePeriod: %d
 	Deposit: %s
-  `, params.ActiveThreshold, params.LegacyThreshold, params.VotePeriod, params.Deposit)
+  `, params.ActiveThreshold, params.LegacyThreshold, params.Quorum, params.VetoThreshold, params.VotePeriod, params.Deposit)
 }
@@ -9,12 +9,16 @@ import (
 
 // Program defines the basic properties of a staking Program
 type Program struct {
-	ProgramID   uint64         `json:"program_id"`  // ID of the Program
-	Title       string         `json:"title"`       // Title of the Program
-	Description string         `json:"description"` // Description of the Program
-	Submitter   sdk.AccAddress `json:"submitter"`   // Validator address of the proposer
-	Executor    sdk.AccAddress `json:"executor"`    // Account address of the executor
-	SubmitBlock int64          `json:"submit_time"` // Block height from which the Program is open for votations
+	ProgramID       uint64         `json:"program_id"`       // ID of the Program
+	Title           string         `json:"title"`            // Title of the Program
+	Description     string         `json:"description"`      // Description of the Program
+	Submitter       sdk.AccAddress `json:"submitter"`        // Validator address of the proposer
+	Executor        sdk.AccAddress `json:"executor"`         // Account address of the executor
+	SubmitBlock     int64          `json:"submit_time"`      // Block height from which the Program is open for votations
+	RequestedBudget sdk.Coin       `json:"requested_budget"` // Total budget requested by the Program
+	EpochCeiling    sdk.Int        `json:"epoch_ceiling"`    // Maximum amount of the requested budget paid in an epoch
+	Milestones      Milestones     `json:"milestones"`       // Optional tranches of the requested budget, unlocked by validator approval
+	Disbursed       sdk.Int        `json:"disbursed"`        // Amount of the requested budget paid so far
 }
 
 // NewProgram validates deposit and creates a new Program
@@ -24,14 +28,21 @@ func NewProgram(
 	description string,
 	submitter sdk.AccAddress,
 	executor sdk.AccAddress,
-	submitBlock int64) Program {
+	submitBlock int64,
+	requestedBudget sdk.Coin,
+	epochCeiling sdk.Int,
+	milestones Milestones) Program {
 	return Program{
-		ProgramID:   programID,
-		Title:       title,
-		Description: description,
-		Submitter:   submitter,
-		Executor:    executor,
-		SubmitBlock: submitBlock,
+		ProgramID:       programID,
+		Title:           title,
+		Description:     description,
+		Submitter:       submitter,
+		Executor:        executor,
+		SubmitBlock:     submitBlock,
+		RequestedBudget: requestedBudget,
+		EpochCeiling:    epochCeiling,
+		Milestones:      milestones,
+		Disbursed:       sdk.ZeroInt(),
 	}
 }
 
@@ -39,6 +50,31 @@ func (p *Program) getVotingEndBlock(ctx sdk.Context, k Keeper) int64 {
 	return p.SubmitBlock + k.GetParams(ctx).VotePeriod
 }
 
+// unlockedBudget returns the part of the requested budget the Program may be paid; the whole budget
+// without milestones, the sum of the approved milestones otherwise
+func (p Program) unlockedBudget() sdk.Int {
+	if len(p.Milestones) == 0 {
+		return p.RequestedBudget.Amount
+	}
+
+	unlocked := sdk.ZeroInt()
+	for _, milestone := range p.Milestones {
+		if milestone.Approved {
+			unlocked = unlocked.Add(milestone.Amount)
+		}
+	}
+	return unlocked
+}
+
+// payableAmount returns the most the Program may be paid in an epoch, in the denom of its requested budget
+func (p Program) payableAmount() sdk.Int {
+	remaining := p.unlockedBudget().Sub(p.Disbursed)
+	if !remaining.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return sdk.MinInt(p.EpochCeiling, remaining)
+}
+
 // String implements fmt.Stringer
 func (p Program) String() string {
 	return fmt.Sprintf(`Program
@@ -47,8 +83,13 @@ func (p Program) String() string {
 	Description: %s
 	Submitter: %v
 	Executor: %v
-	SubmitBlock: %d`,
-		p.ProgramID, p.Title, p.Description, p.Submitter, p.Executor, p.SubmitBlock)
+	SubmitBlock: %d
+	RequestedBudget: %s
+	EpochCeiling: %s
+	Disbursed: %s
+	Milestones: %s`,
+		p.ProgramID, p.Title, p.Description, p.Submitter, p.Executor, p.SubmitBlock,
+		p.RequestedBudget, p.EpochCeiling, p.Disbursed, p.Milestones)
 }
 
 // Programs is a collection of Program
@@ -60,3 +101,68 @@ func (p Programs) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// Claim is the weight of a Program in the claim pool of the current epoch
+type Claim struct {
+	ProgramID uint64  `json:"program_id"` // ID of the Program
+	Weight    sdk.Int `json:"weight"`     // Voting power backing the Program
+}
+
+// NewClaim creates a Claim instance
+func NewClaim(programID uint64, weight sdk.Int) Claim {
+	return Claim{
+		ProgramID: programID,
+		Weight:    weight,
+	}
+}
+
+// String implements fmt.Stringer
+func (c Claim) String() string {
+	return fmt.Sprintf(`Claim
+	ProgramID: %d
+	Weight: %s`, c.ProgramID, c.Weight)
+}
+
+// Claims is a collection of Claim
+type Claims []Claim
+
+func (c Claims) String() (out string) {
+	for _, val := range c {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// validateBudget checks the requested budget, its per-epoch ceiling and the milestones splitting it
+func validateBudget(requestedBudget sdk.Coin, epochCeiling sdk.Int, milestones Milestones) sdk.Error {
+	if len(requestedBudget.Denom) == 0 || !requestedBudget.IsPositive() {
+		return ErrInvalidBudget(fmt.Sprintf("requested budget must be positive, is %s", requestedBudget))
+	}
+
+	if !epochCeiling.IsPositive() || epochCeiling.GT(requestedBudget.Amount) {
+		return ErrInvalidBudget(fmt.Sprintf("epoch ceiling must be positive and at most the requested budget, is %s", epochCeiling))
+	}
+
+	if len(milestones) == 0 {
+		return nil
+	}
+
+	milestoneSum := sdk.ZeroInt()
+	for _, milestone := range milestones {
+		if len(strings.TrimSpace(milestone.Title)) <= 0 {
+			return ErrInvalidBudget("milestone title cannot be empty")
+		}
+
+		if !milestone.Amount.IsPositive() {
+			return ErrInvalidBudget(fmt.Sprintf("milestone amount must be positive, is %s", milestone.Amount))
+		}
+
+		milestoneSum = milestoneSum.Add(milestone.Amount)
+	}
+
+	if !milestoneSum.Equal(requestedBudget.Amount) {
+		return ErrInvalidBudget(fmt.Sprintf("milestones must add up to the requested budget %s, add up to %s", requestedBudget.Amount, milestoneSum))
+	}
+
+	return nil
+}
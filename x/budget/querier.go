@@ -12,11 +12,12 @@ import (
 
 // query endpoints supported by the governance Querier
 const (
-	QueryProgram       = "program"
-	QueryVotes         = "votes"
-	QueryActiveList    = "active-list"
-	QueryCandidateList = "candidate-list"
-	QueryParams        = "params"
+	QueryProgram        = "program"
+	QueryVotes          = "votes"
+	QueryDelegatorVotes = "delegator-votes"
+	QueryActiveList     = "active-list"
+	QueryCandidateList  = "candidate-list"
+	QueryParams         = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -27,6 +28,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryProgram(ctx, path[1:], req, keeper)
 		case QueryVotes:
 			return queryVotes(ctx, req, keeper)
+		case QueryDelegatorVotes:
+			return queryDelegatorVotes(ctx, req, keeper)
 		case QueryActiveList:
 			return queryActiveList(ctx, req, keeper)
 		case QueryCandidateList:
@@ -95,7 +98,7 @@ func queryVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	filteredVotes := Votes{}
 	prefix := prefixVote
-	handler := func(programID uint64, voter sdk.AccAddress, option bool) (stop bool) {
+	handler := func(programID uint64, voter sdk.AccAddress, option VoteOption) (stop bool) {
 		vote := NewVote(programID, option, voter)
 		filteredVotes = append(filteredVotes, vote)
 
@@ -107,7 +110,7 @@ func queryVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	} else if params.ProgramID != 0 {
 		prefix = keyVote(params.ProgramID, sdk.AccAddress{})
 	} else if !params.Voter.Empty() {
-		handler = func(programID uint64, voter sdk.AccAddress, option bool) (stop bool) {
+		handler = func(programID uint64, voter sdk.AccAddress, option VoteOption) (stop bool) {
 			if params.Voter.Equals(voter) {
 				vote := NewVote(programID, option, voter)
 				filteredVotes = append(filteredVotes, vote)
@@ -127,6 +130,101 @@ func queryVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	return bz, nil
 }
 
+// MaxDelegatorVotesLimit is the most delegator votes returned by a single delegator-votes query
+const MaxDelegatorVotesLimit = 100
+
+// Params for query 'custom/budget/delegator-votes'
+type QueryDelegatorVotesParams struct {
+	Delegator sdk.AccAddress
+	ProgramID uint64
+	Page      int
+	Limit     int
+}
+
+// creates a new instance of QueryDelegatorVotesParams
+func NewQueryDelegatorVotesParams(delegator sdk.AccAddress, programID uint64, page, limit int) QueryDelegatorVotesParams {
+	return QueryDelegatorVotesParams{
+		Delegator: delegator,
+		ProgramID: programID,
+		Page:      page,
+		Limit:     limit,
+	}
+}
+
+// JSON response format
+type QueryDelegatorVotesResponse struct {
+	Votes DelegatorVotes `json:"votes"`
+}
+
+func (r QueryDelegatorVotesResponse) String() (out string) {
+	out = r.Votes.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryDelegatorVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegatorVotesParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Delegator.Empty() {
+		return nil, sdk.ErrUnknownRequest("delegator address is required")
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	if params.Limit < 1 || params.Limit > MaxDelegatorVotesLimit {
+		params.Limit = MaxDelegatorVotesLimit
+	}
+
+	// Stop iterating once the requested page is filled
+	start, end := (params.Page-1)*params.Limit, params.Page*params.Limit
+
+	delegatorVotes := DelegatorVotes{}
+	addDelegatorVotes := func(programID uint64) (filled bool) {
+		option, vErr := keeper.GetVote(ctx, programID, params.Delegator)
+		voted := vErr == nil
+
+		keeper.ds.IterateDelegations(ctx, params.Delegator, func(_ int64, delegation sdk.Delegation) (stop bool) {
+			valAddr := delegation.GetValidatorAddr()
+			if voted {
+				delegatorVotes = append(delegatorVotes, NewDelegatorVote(programID, params.Delegator, valAddr, option, false))
+			} else if valOption, vErr := keeper.GetVote(ctx, programID, sdk.AccAddress(valAddr)); vErr == nil {
+				delegatorVotes = append(delegatorVotes, NewDelegatorVote(programID, params.Delegator, valAddr, valOption, true))
+			}
+
+			return len(delegatorVotes) >= end
+		})
+
+		return len(delegatorVotes) >= end
+	}
+
+	if params.ProgramID != 0 {
+		addDelegatorVotes(params.ProgramID)
+	} else {
+		keeper.IteratePrograms(ctx, false, func(program Program) (stop bool) {
+			return addDelegatorVotes(program.ProgramID)
+		})
+	}
+
+	if start >= len(delegatorVotes) {
+		delegatorVotes = DelegatorVotes{}
+	} else {
+		delegatorVotes = delegatorVotes[start:]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryDelegatorVotesResponse{Votes: delegatorVotes})
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 // JSON response format
 type QueryActiveListResponse struct {
 	Actives Programs `json:"actives"`
@@ -5,8 +5,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/terra-project/core/types/mock"
+
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +51,23 @@ func getQueriedVotes(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sd
 	return response.Votes
 }
 
+func getQueriedDelegatorVotes(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegator sdk.AccAddress, programID uint64, page, limit int) DelegatorVotes {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryDelegatorVotes}, "/"),
+		Data: cdc.MustMarshalJSON(NewQueryDelegatorVotesParams(delegator, programID, page, limit)),
+	}
+
+	bz, err := querier(ctx, []string{QueryDelegatorVotes}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var response QueryDelegatorVotesResponse
+	err2 := cdc.UnmarshalJSON(bz, &response)
+	require.Nil(t, err2)
+
+	return response.Votes
+}
+
 func getQueriedActiveList(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) Programs {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, QuerierRoute, QueryActiveList}, "/"),
@@ -132,7 +152,7 @@ func TestQueryVotes(t *testing.T) {
 
 	var votes Votes
 	for _, addr := range addrs {
-		vote := NewVote(testProgram.ProgramID, true, addr)
+		vote := NewVote(testProgram.ProgramID, OptionYes, addr)
 		votes = append(votes, vote)
 
 		input.budgetKeeper.AddVote(input.ctx, vote.ProgramID, vote.Voter, vote.Option)
@@ -155,6 +175,49 @@ func TestQueryVotes(t *testing.T) {
 	require.Equal(t, queriedVotesWithBoth, votes[1:2])
 }
 
+func TestQueryDelegatorVotes(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.budgetKeeper)
+
+	testProgram := generateTestProgram(input.ctx, input.budgetKeeper)
+	input.budgetKeeper.StoreProgram(input.ctx, testProgram)
+
+	// The delegator delegates to two validators
+	delegator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	ds := mock.NewMockDelegationSet()
+	ds.Delegations = append(ds.Delegations,
+		mock.NewMockDelegation(delegator, sdk.ValAddress(addrs[0]), sdk.NewDec(10)),
+		mock.NewMockDelegation(delegator, sdk.ValAddress(addrs[1]), sdk.NewDec(20)),
+	)
+	input.budgetKeeper.ds = ds
+
+	// Only the first validator voted; the delegator inherits its vote for that delegation
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, addrs[0], OptionYes)
+
+	queriedVotes := getQueriedDelegatorVotes(t, input.ctx, input.cdc, querier, delegator, testProgram.ProgramID, 1, 0)
+	require.Equal(t, DelegatorVotes{
+		NewDelegatorVote(testProgram.ProgramID, delegator, sdk.ValAddress(addrs[0]), OptionYes, true),
+	}, queriedVotes)
+
+	// The vote of the delegator overrides the vote of the validator, for every delegation
+	input.budgetKeeper.AddVote(input.ctx, testProgram.ProgramID, delegator, OptionNo)
+
+	queriedVotes = getQueriedDelegatorVotes(t, input.ctx, input.cdc, querier, delegator, 0, 1, 0)
+	require.Equal(t, DelegatorVotes{
+		NewDelegatorVote(testProgram.ProgramID, delegator, sdk.ValAddress(addrs[0]), OptionNo, false),
+		NewDelegatorVote(testProgram.ProgramID, delegator, sdk.ValAddress(addrs[1]), OptionNo, false),
+	}, queriedVotes)
+
+	// Votes are paged
+	queriedVotes = getQueriedDelegatorVotes(t, input.ctx, input.cdc, querier, delegator, 0, 2, 1)
+	require.Equal(t, DelegatorVotes{
+		NewDelegatorVote(testProgram.ProgramID, delegator, sdk.ValAddress(addrs[1]), OptionNo, false),
+	}, queriedVotes)
+
+	queriedVotes = getQueriedDelegatorVotes(t, input.ctx, input.cdc, querier, delegator, 0, 3, 1)
+	require.Empty(t, queriedVotes)
+}
+
 func TestQueryActiveList(t *testing.T) {
 	input := createTestInput(t)
 	querier := NewQuerier(input.budgetKeeper)
@@ -9,8 +9,12 @@ var (
 	ActionProgramLegacied = "program-legacied"
 	ActionProgramPassed   = "program-passed"
 	ActionProgramRejected = "program-rejected"
+	ActionProgramVetoed   = "program-vetoed"
 	ActionProgramGranted  = "program-grant"
 
+	ActionMilestoneClaimed  = "milestone-claimed"
+	ActionMilestoneApproved = "milestone-approved"
+
 	Action            = sdk.TagAction
 	Submitter         = "submitter"
 	ProgramID         = "program-id"
@@ -19,4 +23,5 @@ var (
 	Voter             = "voter"
 	Weight            = "weight"
 	Option            = "option"
+	Milestone         = "milestone"
 )
@@ -5,7 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
@@ -85,6 +85,7 @@ func createTestInput(t *testing.T) testInput {
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyBudget := sdk.NewKVStoreKey(StoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasury.StoreKey)
@@ -104,6 +105,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyBudget, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
@@ -153,12 +155,17 @@ func createTestInput(t *testing.T) testInput {
 		bankKeeper, &stakingKeeper, feeCollectionKeeper, distr.DefaultCodespace,
 	)
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := mint.NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	oracleKeeper := oracle.NewKeeper(
@@ -176,6 +183,9 @@ func createTestInput(t *testing.T) testInput {
 		keyMarket,
 		oracleKeeper,
 		mintKeeper,
+		bankKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(market.DefaultParamspace),
 	)
 
@@ -185,6 +195,9 @@ func createTestInput(t *testing.T) testInput {
 		stakingKeeper.GetValidatorSet(),
 		mintKeeper,
 		marketKeeper,
+		oracleKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(treasury.DefaultParamspace),
 	)
 
@@ -211,14 +224,20 @@ func createTestInput(t *testing.T) testInput {
 		cdc,
 		keyBudget,
 		marketKeeper,
+		oracleKeeper,
 		mintKeeper,
 		treasuryKeeper,
 		stakingKeeper.GetValidatorSet(),
+		stakingKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(DefaultParamspace),
 	)
 
 	InitGenesis(ctx, budgetKeeper, DefaultGenesisState())
 
+	// Requested budgets must be in a denom with a swap rate
+	oracleKeeper.SetLunaSwapRate(ctx, assets.MicroSDRDenom, sdk.OneDec())
+
 	return testInput{ctx, cdc, mintKeeper, bankKeeper, budgetKeeper, treasuryKeeper}
 }
 
@@ -235,5 +254,6 @@ func generateTestProgram(ctx sdk.Context, budgetKeeper Keeper, accounts ...sdk.A
 
 	testProgramID := budgetKeeper.NewProgramID(ctx)
 
-	return NewProgram(testProgramID, "testTitle", "testDescription", submitter, executor, util.GetEpoch(ctx).Int64())
+	return NewProgram(testProgramID, "testTitle", "testDescription", submitter, executor, budgetKeeper.ck.GetEpoch(ctx).Int64(),
+		sdk.NewInt64Coin(assets.MicroLunaDenom, 1000000), sdk.NewInt(1000000), nil)
 }
@@ -1,11 +1,107 @@
 package budget
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// VoteOption defines a vote option on a Program
+type VoteOption byte
+
+// Vote options. Votes stored as bools before options were enumerated decode as OptionYes (true)
+// or OptionEmpty (false); OptionEmpty is read back as OptionNo.
+const (
+	OptionEmpty      VoteOption = 0x00
+	OptionYes        VoteOption = 0x01
+	OptionAbstain    VoteOption = 0x02
+	OptionNo         VoteOption = 0x03
+	OptionNoWithVeto VoteOption = 0x04
+)
+
+// VoteOptionFromString returns a VoteOption from a string; it is case insensitive, and accepts
+// no_with_veto for NoWithVeto
+func VoteOptionFromString(str string) (VoteOption, error) {
+	switch strings.ToLower(str) {
+	case "yes":
+		return OptionYes, nil
+	case "abstain":
+		return OptionAbstain, nil
+	case "no":
+		return OptionNo, nil
+	case "nowithveto", "no_with_veto":
+		return OptionNoWithVeto, nil
+	default:
+		return VoteOption(0xff), fmt.Errorf("'%s' is not a valid vote option", str)
+	}
+}
+
+// ValidVoteOption returns true if the vote option is one a voter can choose
+func ValidVoteOption(option VoteOption) bool {
+	return option == OptionYes ||
+		option == OptionAbstain ||
+		option == OptionNo ||
+		option == OptionNoWithVeto
+}
+
+// migrateVoteOption reads a stored option; votes stored as false are no votes
+func migrateVoteOption(option VoteOption) VoteOption {
+	if option == OptionEmpty {
+		return OptionNo
+	}
+	return option
+}
+
+// MarshalJSON implements json.Marshaler
+func (vo VoteOption) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vo.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Options exported as bools before they were
+// enumerated are read as yes or no votes.
+func (vo *VoteOption) UnmarshalJSON(data []byte) error {
+	var legacyOption bool
+	if err := json.Unmarshal(data, &legacyOption); err == nil {
+		if legacyOption {
+			*vo = OptionYes
+		} else {
+			*vo = OptionNo
+		}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	option, err := VoteOptionFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*vo = option
+	return nil
+}
+
+// String implements fmt.Stringer
+func (vo VoteOption) String() string {
+	switch vo {
+	case OptionYes:
+		return "Yes"
+	case OptionAbstain:
+		return "Abstain"
+	case OptionNo:
+		return "No"
+	case OptionNoWithVeto:
+		return "NoWithVeto"
+	default:
+		return ""
+	}
+}
+
 // no-lint
 type Vote MsgVoteProgram
 
@@ -14,7 +110,7 @@ func (v Vote) String() string {
 }
 
 // NewMsgVoteProgram creates a MsgVoteProgram instance
-func NewVote(programID uint64, option bool, voter sdk.AccAddress) Vote {
+func NewVote(programID uint64, option VoteOption, voter sdk.AccAddress) Vote {
 	return Vote(
 		MsgVoteProgram{
 			ProgramID: programID,
@@ -32,3 +128,79 @@ func (v Votes) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// DelegatorVote is the vote cast on a Program with the delegation of a delegator to a validator;
+// the vote of the delegator if it voted, inherited from the validator otherwise
+type DelegatorVote struct {
+	ProgramID uint64         `json:"program_id"` // ID of the Program
+	Delegator sdk.AccAddress `json:"delegator"`  // Address of the delegator
+	Validator sdk.ValAddress `json:"validator"`  // Address of the validator delegated to
+	Option    VoteOption     `json:"option"`     // Option voted with the delegation
+	Inherited bool           `json:"inherited"`  // Whether the option is inherited from the validator
+}
+
+// NewDelegatorVote creates a DelegatorVote instance
+func NewDelegatorVote(programID uint64, delegator sdk.AccAddress, validator sdk.ValAddress, option VoteOption, inherited bool) DelegatorVote {
+	return DelegatorVote{
+		ProgramID: programID,
+		Delegator: delegator,
+		Validator: validator,
+		Option:    option,
+		Inherited: inherited,
+	}
+}
+
+func (v DelegatorVote) String() string {
+	return fmt.Sprintf(`DelegatorVote
+	ProgramID: %v
+	Delegator: %v
+	Validator: %v
+	Option: %v
+	Inherited: %v`, v.ProgramID, v.Delegator, v.Validator, v.Option, v.Inherited)
+}
+
+// DelegatorVotes is a collection of DelegatorVote
+type DelegatorVotes []DelegatorVote
+
+func (v DelegatorVotes) String() (out string) {
+	for _, val := range v {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// TallyResult is the voting power cast for each option on a Program, in bonded tokens
+type TallyResult struct {
+	Yes        sdk.Int `json:"yes"`
+	Abstain    sdk.Int `json:"abstain"`
+	No         sdk.Int `json:"no"`
+	NoWithVeto sdk.Int `json:"no_with_veto"`
+}
+
+// NewTallyResult creates a TallyResult instance
+func NewTallyResult(yes, abstain, no, noWithVeto sdk.Int) TallyResult {
+	return TallyResult{
+		Yes:        yes,
+		Abstain:    abstain,
+		No:         no,
+		NoWithVeto: noWithVeto,
+	}
+}
+
+// VotePower returns the weight of the Program; yes votes minus no votes, vetoes included
+func (r TallyResult) VotePower() sdk.Int {
+	return r.Yes.Sub(r.No).Sub(r.NoWithVeto)
+}
+
+// Participation returns the voting power cast on the Program, abstentions included
+func (r TallyResult) Participation() sdk.Int {
+	return r.Yes.Add(r.Abstain).Add(r.No).Add(r.NoWithVeto)
+}
+
+func (r TallyResult) String() string {
+	return fmt.Sprintf(`TallyResult
+	Yes: %s
+	Abstain: %s
+	No: %s
+	NoWithVeto: %s`, r.Yes, r.Abstain, r.No, r.NoWithVeto)
+}
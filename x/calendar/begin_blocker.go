@@ -0,0 +1,41 @@
+package calendar
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker closes the current day of a time calendar once the block time crosses the end of
+// the day. The block is then the last block of the day, so that the end blockers of the other
+// modules run their end of day and end of epoch logic on it, and the next day starts at the next block.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	params := k.GetParams(ctx)
+	if params.Mode != ModeTime {
+		return
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	dayStartTime := k.GetDayStartTime(ctx)
+
+	// The calendar starts with the first block when the genesis does not set a start time
+	if dayStartTime.IsZero() {
+		k.setDayStartTime(ctx, blockTime)
+		return
+	}
+
+	elapsed := blockTime.Sub(dayStartTime)
+	if elapsed < params.DayDuration {
+		return
+	}
+
+	// Days the chain was halted through are recorded without blocks, so that days and epochs keep
+	// matching the calendar and the next day ends on schedule
+	days := int64(elapsed / params.DayDuration)
+	lastDay := k.getLastDay(ctx)
+	for day := lastDay + 1; day <= lastDay+days; day++ {
+		k.recordDayStart(ctx, day, ctx.BlockHeight()+1)
+	}
+
+	k.setDayStartTime(ctx, dayStartTime.Add(time.Duration(days)*params.DayDuration))
+}
@@ -0,0 +1,12 @@
+package calendar
+
+const (
+	// ModuleName is the name of the calendar module
+	ModuleName = "calendar"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
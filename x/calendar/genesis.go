@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all calendar state that must be provided at genesis
+type GenesisState struct {
+	Params       Params    `json:"params"`         // calendar params
+	StartDay     int64     `json:"start_day"`      // day of the first block, where the days of an exported chain carry on
+	DayStartTime time.Time `json:"day_start_time"` // start time of the latest day of a time calendar
+}
+
+func NewGenesisState(params Params, startDay int64, dayStartTime time.Time) GenesisState {
+	return GenesisState{
+		Params:       params,
+		StartDay:     startDay,
+		DayStartTime: dayStartTime,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// InitGenesis sets the calendar params and the day the chain starts at, so that the days and epochs
+// keying the history of the other modules carry on after an export. A time calendar starts the day
+// at genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.setStartDay(ctx, data.StartDay)
+	if data.Params.Mode != ModeTime {
+		return
+	}
+
+	keeper.recordDayStart(ctx, data.StartDay, ctx.BlockHeight())
+	keeper.setDayStartTime(ctx, data.DayStartTime)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper. The chain restarts at the
+// day of the next block; a day closed by the exported block is not run again.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	startDay := keeper.dayAt(ctx, ctx.BlockHeight()+1)
+	return NewGenesisState(keeper.GetParams(ctx), startDay, keeper.GetDayStartTime(ctx))
+}
+
+// ValidateGenesis validates the provided calendar genesis state to ensure the
+// expected invariants holds. (i.e. params in correct bounds)
+func ValidateGenesis(data GenesisState) error {
+	if err := validateParams(data.Params); err != nil {
+		return err
+	}
+
+	if data.StartDay < 0 {
+		return fmt.Errorf("Invalid start day %d: should not be negative", data.StartDay)
+	}
+
+	return nil
+}
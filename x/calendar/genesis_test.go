@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportInitGenesis(t *testing.T) {
+	input := createTestInput(t)
+
+	params := NewParams(ModeBlock, 60, 300, time.Minute, 5*time.Minute)
+	genesis := NewGenesisState(params, 0, time.Time{})
+	InitGenesis(input.ctx, input.calendarKeeper, genesis)
+	require.Equal(t, params, input.calendarKeeper.GetParams(input.ctx))
+	require.Equal(t, genesis, ExportGenesis(input.ctx, input.calendarKeeper))
+
+	// Time calendars carry the start time of the current day
+	params.Mode = ModeTime
+	genesis = NewGenesisState(params, 0, time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	InitGenesis(input.ctx, input.calendarKeeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(input.ctx, input.calendarKeeper))
+}
+
+func TestExportImportAtHeightZero(t *testing.T) {
+	input := createTestInput(t)
+
+	params := NewParams(ModeBlock, 60, 300, time.Minute, 5*time.Minute)
+	InitGenesis(input.ctx, input.calendarKeeper, NewGenesisState(params, 0, time.Time{}))
+
+	// Exported in the middle of day 10, the new chain carries on with day 10
+	genesis := ExportGenesis(input.ctx.WithBlockHeight(630), input.calendarKeeper)
+	require.Equal(t, int64(10), genesis.StartDay)
+
+	// Exported at the last block of day 10, the new chain starts with day 11
+	genesis = ExportGenesis(input.ctx.WithBlockHeight(659), input.calendarKeeper)
+	require.Equal(t, int64(11), genesis.StartDay)
+
+	newInput := createTestInput(t)
+	ctx := newInput.ctx.WithBlockHeight(0)
+	InitGenesis(ctx, newInput.calendarKeeper, genesis)
+
+	require.Equal(t, sdk.NewInt(11), newInput.calendarKeeper.GetDay(ctx))
+	require.Equal(t, sdk.NewInt(2), newInput.calendarKeeper.GetEpoch(ctx))
+	require.True(t, newInput.calendarKeeper.IsEpochLastBlock(ctx.WithBlockHeight(239)))
+	require.False(t, newInput.calendarKeeper.IsEpochLastBlock(ctx.WithBlockHeight(59)))
+
+	// Epochs of the exported chain resolve to blocks before genesis
+	for epoch := int64(0); epoch < 2; epoch++ {
+		firstBlock := newInput.calendarKeeper.EpochFirstBlock(ctx, sdk.NewInt(epoch))
+		lastBlock := newInput.calendarKeeper.EpochLastBlock(ctx, sdk.NewInt(epoch))
+		require.Equal(t, sdk.NewInt(epoch*5), newInput.calendarKeeper.GetDay(ctx.WithBlockHeight(firstBlock)))
+		require.Equal(t, sdk.NewInt(epoch*5+4), newInput.calendarKeeper.GetDay(ctx.WithBlockHeight(lastBlock)))
+	}
+
+	// Time calendars carry on with the current day and its start time
+	genesisTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	params.Mode = ModeTime
+	input = createTestInput(t)
+	InitGenesis(input.ctx, input.calendarKeeper, NewGenesisState(params, 0, genesisTime))
+
+	blockTime := genesisTime
+	for height := int64(1); height < 100; height++ {
+		blockTime = blockTime.Add(7 * time.Second)
+		input.ctx = input.ctx.WithBlockHeight(height).WithBlockHeader(abci.Header{Height: height, Time: blockTime})
+		BeginBlocker(input.ctx, input.calendarKeeper)
+	}
+
+	day := input.calendarKeeper.GetDay(input.ctx)
+	genesis = ExportGenesis(input.ctx, input.calendarKeeper)
+	require.Equal(t, day.Int64(), genesis.StartDay)
+
+	newInput = createTestInput(t)
+	ctx = newInput.ctx.WithBlockHeight(0)
+	InitGenesis(ctx, newInput.calendarKeeper, genesis)
+	require.Equal(t, day, newInput.calendarKeeper.GetDay(ctx))
+	require.Equal(t, input.calendarKeeper.GetDayStartTime(input.ctx), newInput.calendarKeeper.GetDayStartTime(ctx))
+
+	for epoch := int64(0); epoch < day.Int64()/5; epoch++ {
+		lastBlock := newInput.calendarKeeper.EpochLastBlock(ctx, sdk.NewInt(epoch))
+		require.Equal(t, sdk.NewInt(epoch), newInput.calendarKeeper.GetEpoch(ctx.WithBlockHeight(lastBlock)))
+	}
+
+	// The next block past the end of the day closes it
+	ctx = ctx.WithBlockHeight(1).WithBlockHeader(abci.Header{Height: 1, Time: genesis.DayStartTime.Add(time.Minute)})
+	BeginBlocker(ctx, newInput.calendarKeeper)
+	require.True(t, newInput.calendarKeeper.IsDayLastBlock(ctx))
+	require.Equal(t, day.AddRaw(1), newInput.calendarKeeper.GetDay(ctx.WithBlockHeight(2)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params = NewParams(ModeBlock, 60, 300, time.Minute, 5*time.Minute)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	// Non-positive lengths
+	genesis.Params = NewParams(ModeBlock, 0, 300, time.Minute, 5*time.Minute)
+	require.Error(t, ValidateGenesis(genesis))
+	genesis.Params = NewParams(ModeBlock, 60, -300, time.Minute, 5*time.Minute)
+	require.Error(t, ValidateGenesis(genesis))
+	genesis.Params = NewParams(ModeTime, 60, 300, 0, 5*time.Minute)
+	require.Error(t, ValidateGenesis(genesis))
+
+	// Epochs that do not split into days
+	genesis.Params = NewParams(ModeBlock, 60, 90, time.Minute, 5*time.Minute)
+	require.Error(t, ValidateGenesis(genesis))
+	genesis.Params = NewParams(ModeTime, 60, 300, time.Minute, 90*time.Second)
+	require.Error(t, ValidateGenesis(genesis))
+
+	// Unknown mode
+	genesis.Params = NewParams("weekly", 60, 300, time.Minute, 5*time.Minute)
+	require.Error(t, ValidateGenesis(genesis))
+
+	// The start day cannot be negative
+	genesis.Params = NewParams(ModeTime, 60, 300, time.Minute, 5*time.Minute)
+	genesis.StartDay = 12
+	require.NoError(t, ValidateGenesis(genesis))
+	genesis.StartDay = -1
+	require.Error(t, ValidateGenesis(genesis))
+}
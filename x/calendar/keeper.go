@@ -0,0 +1,220 @@
+package calendar
+
+import (
+	"sort"
+	"time"
+
+	"github.com/terra-project/core/types/util"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper of the calendar module. Splits the chain into days and epochs, counted either in blocks
+// or in block time.
+type Keeper struct {
+	cdc *codec.Codec
+	key sdk.StoreKey
+
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a new instance of the calendar module.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramspace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		key:        key,
+		paramSpace: paramspace.WithKeyTable(paramKeyTable()),
+	}
+}
+
+// BlocksPerDay returns the number of blocks in a day of a block calendar
+func (k Keeper) BlocksPerDay(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).BlocksPerDay
+}
+
+// BlocksPerEpoch returns the number of blocks in an epoch of a block calendar
+func (k Keeper) BlocksPerEpoch(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).BlocksPerEpoch
+}
+
+// DaysPerEpoch returns the number of days in an epoch
+func (k Keeper) DaysPerEpoch(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).DaysPerEpoch()
+}
+
+// GetDay returns the current day, starting from 0
+func (k Keeper) GetDay(ctx sdk.Context) sdk.Int {
+	return sdk.NewInt(k.dayAt(ctx, ctx.BlockHeight()))
+}
+
+// GetEpoch returns the current epoch, starting from 0
+func (k Keeper) GetEpoch(ctx sdk.Context) sdk.Int {
+	return sdk.NewInt(k.dayAt(ctx, ctx.BlockHeight()) / k.DaysPerEpoch(ctx))
+}
+
+// IsDayLastBlock returns true if we are at the last block of the day
+func (k Keeper) IsDayLastBlock(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	if params.Mode != ModeTime {
+		return util.IsPeriodLastBlock(ctx, params.BlocksPerDay)
+	}
+
+	return k.dayAt(ctx, ctx.BlockHeight()+1) != k.dayAt(ctx, ctx.BlockHeight())
+}
+
+// IsEpochLastBlock returns true if we are at the last block of the epoch. After a halt, the next
+// block may start a later epoch than the one following the current epoch.
+func (k Keeper) IsEpochLastBlock(ctx sdk.Context) bool {
+	daysPerEpoch := k.DaysPerEpoch(ctx)
+	return k.IsDayLastBlock(ctx) &&
+		k.dayAt(ctx, ctx.BlockHeight()+1)/daysPerEpoch != k.dayAt(ctx, ctx.BlockHeight())/daysPerEpoch
+}
+
+// EpochFirstBlock returns the height of the first block of {epoch}. On a time calendar, an epoch
+// that has not started yet starts after the current block at the earliest. Epochs before genesis
+// start at negative heights that resolve back to them.
+func (k Keeper) EpochFirstBlock(ctx sdk.Context, epoch sdk.Int) int64 {
+	params := k.GetParams(ctx)
+	firstDay := epoch.Int64() * params.DaysPerEpoch()
+	if params.Mode == ModeTime && firstDay > k.getLastDay(ctx) {
+		return ctx.BlockHeight() + 1
+	}
+
+	return k.dayStartAt(ctx, firstDay)
+}
+
+// EpochLastBlock returns the height of the last block of {epoch}. On a time calendar, the end of
+// the current epoch is not known yet and the current block is returned.
+func (k Keeper) EpochLastBlock(ctx sdk.Context, epoch sdk.Int) int64 {
+	params := k.GetParams(ctx)
+	nextFirstDay := (epoch.Int64() + 1) * params.DaysPerEpoch()
+	if params.Mode == ModeTime && nextFirstDay > k.getLastDay(ctx) {
+		return ctx.BlockHeight()
+	}
+
+	return k.dayStartAt(ctx, nextFirstDay) - 1
+}
+
+// dayAt returns the day of the block at {height}. Days carry on from the start day of the genesis;
+// heights before the genesis resolve to the days of the exported chain.
+func (k Keeper) dayAt(ctx sdk.Context, height int64) int64 {
+	params := k.GetParams(ctx)
+	startDay := k.getStartDay(ctx)
+	if params.Mode != ModeTime {
+		return startDay + floorDiv(height, params.BlocksPerDay)
+	}
+
+	// Each day of the exported chain counts as a single block before the genesis
+	startHeight := k.getDayStart(ctx, startDay)
+	if height < startHeight {
+		return startDay - (startHeight - height)
+	}
+
+	lastDay := k.getLastDay(ctx)
+	if height >= k.getDayStart(ctx, lastDay) {
+		return lastDay
+	}
+
+	// Days start at increasing heights; find the first day whose successor starts after {height}
+	return startDay + int64(sort.Search(int(lastDay-startDay), func(i int) bool {
+		return k.getDayStart(ctx, startDay+int64(i)+1) > height
+	}))
+}
+
+// floorDiv divides rounding down, so that negative heights fall in the days before genesis
+func floorDiv(a, b int64) int64 {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// dayStartAt returns the height of the first block of {day}, the inverse of dayAt
+func (k Keeper) dayStartAt(ctx sdk.Context, day int64) int64 {
+	params := k.GetParams(ctx)
+	startDay := k.getStartDay(ctx)
+	if params.Mode != ModeTime {
+		return (day - startDay) * params.BlocksPerDay
+	}
+
+	if day < startDay {
+		return k.getDayStart(ctx, startDay) - (startDay - day)
+	}
+
+	return k.getDayStart(ctx, day)
+}
+
+//-----------------------------------
+// Day boundaries logic
+
+// GetDayStartTime returns the time the latest day of a time calendar started at. Days are aligned
+// on it rather than on the time of the block closing the previous day, so that they do not drift.
+func (k Keeper) GetDayStartTime(ctx sdk.Context) (dayStartTime time.Time) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyDayStartTime); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dayStartTime)
+	}
+	return
+}
+
+func (k Keeper) setDayStartTime(ctx sdk.Context, dayStartTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(dayStartTime)
+	store.Set(keyDayStartTime, bz)
+}
+
+// getStartDay returns the day the chain started at genesis; non-zero after an export
+func (k Keeper) getStartDay(ctx sdk.Context) (day int64) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyStartDay); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &day)
+	}
+	return
+}
+
+func (k Keeper) setStartDay(ctx sdk.Context, day int64) {
+	store := ctx.KVStore(k.key)
+	store.Set(keyStartDay, k.cdc.MustMarshalBinaryLengthPrefixed(day))
+}
+
+// getLastDay returns the latest day recorded by a time calendar
+func (k Keeper) getLastDay(ctx sdk.Context) (day int64) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyLastDay); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &day)
+	}
+	return
+}
+
+// getDayStart returns the height of the first block of a day recorded by a time calendar
+func (k Keeper) getDayStart(ctx sdk.Context, day int64) (height int64) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyDayStart(day)); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &height)
+	}
+	return
+}
+
+// recordDayStart records that {day} starts at {height}; days are recorded in order
+func (k Keeper) recordDayStart(ctx sdk.Context, day int64, height int64) {
+	store := ctx.KVStore(k.key)
+	store.Set(keyDayStart(day), k.cdc.MustMarshalBinaryLengthPrefixed(height))
+	store.Set(keyLastDay, k.cdc.MustMarshalBinaryLengthPrefixed(day))
+}
+
+//-----------------------------------
+// Params logic
+
+// GetParams get calendar params from the global param store
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var resultParams Params
+	k.paramSpace.Get(ctx, paramStoreKeyParams, &resultParams)
+	return resultParams
+}
+
+// SetParams set calendar params from the global param store
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramSpace.Set(ctx, paramStoreKeyParams, &params)
+}
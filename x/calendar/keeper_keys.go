@@ -0,0 +1,27 @@
+package calendar
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// nolint
+var (
+	prefixDayStart  = []byte("day_start")
+	keyStartDay     = []byte("start_day")
+	keyLastDay      = []byte("last_day")
+	keyDayStartTime = []byte("day_start_time")
+
+	paramStoreKeyParams = []byte("params")
+)
+
+func keyDayStart(day int64) []byte {
+	return []byte(fmt.Sprintf("%s:%d", prefixDayStart, day))
+}
+
+func paramKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		paramStoreKeyParams, Params{},
+	)
+}
@@ -0,0 +1,160 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/terra-project/core/types/util"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+type testInput struct {
+	ctx            sdk.Context
+	calendarKeeper Keeper
+}
+
+func createTestInput(t *testing.T) testInput {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyCalendar := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
+	calendarKeeper := NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(DefaultParamspace))
+
+	InitGenesis(ctx, calendarKeeper, DefaultGenesisState())
+
+	return testInput{ctx, calendarKeeper}
+}
+
+func TestKeeperDefaultParams(t *testing.T) {
+	input := createTestInput(t)
+
+	require.Equal(t, util.BlocksPerDay, input.calendarKeeper.BlocksPerDay(input.ctx))
+	require.Equal(t, util.BlocksPerEpoch, input.calendarKeeper.BlocksPerEpoch(input.ctx))
+
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch + util.BlocksPerDay)
+	require.Equal(t, sdk.NewInt(8), input.calendarKeeper.GetDay(input.ctx))
+	require.Equal(t, sdk.OneInt(), input.calendarKeeper.GetEpoch(input.ctx))
+}
+
+func TestKeeperEpochs(t *testing.T) {
+	input := createTestInput(t)
+
+	// One second blocks with epochs of five minutes, split into days of a minute
+	input.calendarKeeper.SetParams(input.ctx, NewParams(ModeBlock, 60, 300, time.Minute, 5*time.Minute))
+
+	for height := int64(0); height < 900; height++ {
+		input.ctx = input.ctx.WithBlockHeight(height)
+
+		require.Equal(t, sdk.NewInt(height/60), input.calendarKeeper.GetDay(input.ctx))
+		require.Equal(t, sdk.NewInt(height/300), input.calendarKeeper.GetEpoch(input.ctx))
+		require.Equal(t, height%60 == 59, input.calendarKeeper.IsDayLastBlock(input.ctx))
+		require.Equal(t, height%300 == 299, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+	}
+}
+
+func TestKeeperTimeEpochs(t *testing.T) {
+	input := createTestInput(t)
+
+	// Days of a minute and epochs of five minutes, whatever the block time
+	genesisTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	params := NewParams(ModeTime, 60, 300, time.Minute, 5*time.Minute)
+	InitGenesis(input.ctx, input.calendarKeeper, NewGenesisState(params, 0, genesisTime))
+
+	// Blocks every 7 seconds
+	blockTime := genesisTime
+	dayStarts := []int64{0}
+	for height := int64(1); height < 400; height++ {
+		blockTime = blockTime.Add(7 * time.Second)
+		input.ctx = input.ctx.WithBlockHeight(height).WithBlockHeader(abci.Header{Height: height, Time: blockTime})
+		BeginBlocker(input.ctx, input.calendarKeeper)
+
+		// The first block past the end of the day closes it
+		day := int64(len(dayStarts) - 1)
+		lastBlock := !blockTime.Before(genesisTime.Add(time.Duration(day+1) * time.Minute))
+		require.Equal(t, sdk.NewInt(day), input.calendarKeeper.GetDay(input.ctx))
+		require.Equal(t, sdk.NewInt(day/5), input.calendarKeeper.GetEpoch(input.ctx))
+		require.Equal(t, lastBlock, input.calendarKeeper.IsDayLastBlock(input.ctx))
+		require.Equal(t, lastBlock && day%5 == 4, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+
+		if lastBlock {
+			dayStarts = append(dayStarts, height+1)
+		}
+	}
+
+	// Past blocks resolve to the day they were in
+	for day, height := range dayStarts {
+		require.Equal(t, sdk.NewInt(int64(day)), input.calendarKeeper.GetDay(input.ctx.WithBlockHeight(height)))
+		if height > 0 {
+			require.Equal(t, sdk.NewInt(int64(day-1)), input.calendarKeeper.GetDay(input.ctx.WithBlockHeight(height-1)))
+		}
+	}
+
+	require.Equal(t, dayStarts[5], input.calendarKeeper.EpochFirstBlock(input.ctx, sdk.OneInt()))
+	require.Equal(t, dayStarts[10]-1, input.calendarKeeper.EpochLastBlock(input.ctx, sdk.OneInt()))
+
+	// The end of the current epoch is not known yet
+	curEpoch := input.calendarKeeper.GetEpoch(input.ctx)
+	require.Equal(t, input.ctx.BlockHeight(), input.calendarKeeper.EpochLastBlock(input.ctx, curEpoch))
+	require.Equal(t, input.ctx.BlockHeight()+1, input.calendarKeeper.EpochFirstBlock(input.ctx, curEpoch.AddRaw(1)))
+}
+
+func TestBeginBlockerHalt(t *testing.T) {
+	input := createTestInput(t)
+
+	genesisTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	params := NewParams(ModeTime, 60, 300, time.Minute, 5*time.Minute)
+	InitGenesis(input.ctx, input.calendarKeeper, NewGenesisState(params, 0, genesisTime))
+
+	// The chain halts for three and a half days; the next block closes the day and the next day is day 3
+	input.ctx = input.ctx.WithBlockHeight(1).WithBlockHeader(abci.Header{Height: 1, Time: genesisTime.Add(210 * time.Second)})
+	BeginBlocker(input.ctx, input.calendarKeeper)
+	require.True(t, input.calendarKeeper.IsDayLastBlock(input.ctx))
+	require.False(t, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+	require.Equal(t, genesisTime.Add(3*time.Minute), input.calendarKeeper.GetDayStartTime(input.ctx))
+
+	// Days stay aligned on the genesis time
+	input.ctx = input.ctx.WithBlockHeight(2).WithBlockHeader(abci.Header{Height: 2, Time: genesisTime.Add(235 * time.Second)})
+	BeginBlocker(input.ctx, input.calendarKeeper)
+	require.Equal(t, sdk.NewInt(3), input.calendarKeeper.GetDay(input.ctx))
+	require.False(t, input.calendarKeeper.IsDayLastBlock(input.ctx))
+
+	input.ctx = input.ctx.WithBlockHeight(3).WithBlockHeader(abci.Header{Height: 3, Time: genesisTime.Add(240 * time.Second)})
+	BeginBlocker(input.ctx, input.calendarKeeper)
+	require.True(t, input.calendarKeeper.IsDayLastBlock(input.ctx))
+	require.False(t, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+
+	// A halt through the end of the epoch closes it, and the epoch of the next block follows the calendar
+	input.ctx = input.ctx.WithBlockHeight(4).WithBlockHeader(abci.Header{Height: 4, Time: genesisTime.Add(570 * time.Second)})
+	BeginBlocker(input.ctx, input.calendarKeeper)
+	require.Equal(t, sdk.NewInt(4), input.calendarKeeper.GetDay(input.ctx))
+	require.True(t, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+	require.Equal(t, sdk.NewInt(9), input.calendarKeeper.GetDay(input.ctx.WithBlockHeight(5)))
+	require.Equal(t, sdk.OneInt(), input.calendarKeeper.GetEpoch(input.ctx.WithBlockHeight(5)))
+
+	// Without a start time, the calendar starts with the first block
+	InitGenesis(input.ctx, input.calendarKeeper, NewGenesisState(params, 0, time.Time{}))
+	input.ctx = input.ctx.WithBlockHeight(5).WithBlockHeader(abci.Header{Height: 5, Time: genesisTime})
+	BeginBlocker(input.ctx, input.calendarKeeper)
+	require.Equal(t, genesisTime, input.calendarKeeper.GetDayStartTime(input.ctx))
+}
@@ -0,0 +1,104 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terra-project/core/types/util"
+)
+
+// Calendar modes
+const (
+	// ModeBlock counts days and epochs in blocks
+	ModeBlock = "block"
+
+	// ModeTime counts days and epochs in block time
+	ModeTime = "time"
+)
+
+// Params calendar parameters
+type Params struct {
+	Mode string `json:"mode"` // whether days are counted in blocks or in block time
+
+	BlocksPerDay   int64 `json:"blocks_per_day"`   // length of a day, the period of mint issuance snapshots and market daily caps
+	BlocksPerEpoch int64 `json:"blocks_per_epoch"` // length of an epoch, the period of treasury policy updates and budget reward distribution
+
+	DayDuration   time.Duration `json:"day_duration"`   // length of a day in time mode
+	EpochDuration time.Duration `json:"epoch_duration"` // length of an epoch in time mode
+}
+
+// NewParams creates a new param instance
+func NewParams(mode string, blocksPerDay, blocksPerEpoch int64, dayDuration, epochDuration time.Duration) Params {
+	return Params{
+		Mode:           mode,
+		BlocksPerDay:   blocksPerDay,
+		BlocksPerEpoch: blocksPerEpoch,
+		DayDuration:    dayDuration,
+		EpochDuration:  epochDuration,
+	}
+}
+
+// DefaultParams creates default calendar module parameters
+func DefaultParams() Params {
+	return NewParams(
+		ModeBlock,
+		util.BlocksPerDay,
+		util.BlocksPerEpoch,
+		24*time.Hour,
+		7*24*time.Hour,
+	)
+}
+
+// DaysPerEpoch returns the number of days in an epoch
+func (params Params) DaysPerEpoch() int64 {
+	if params.Mode == ModeTime {
+		return int64(params.EpochDuration / params.DayDuration)
+	}
+
+	return params.BlocksPerEpoch / params.BlocksPerDay
+}
+
+func validateParams(params Params) error {
+	if params.Mode != ModeBlock && params.Mode != ModeTime {
+		return fmt.Errorf("calendar mode should be %s or %s, is %s", ModeBlock, ModeTime, params.Mode)
+	}
+
+	if params.BlocksPerDay <= 0 {
+		return fmt.Errorf("calendar blocks per day should be positive, is %d", params.BlocksPerDay)
+	}
+
+	if params.BlocksPerEpoch <= 0 {
+		return fmt.Errorf("calendar blocks per epoch should be positive, is %d", params.BlocksPerEpoch)
+	}
+
+	// Mint computes the seigniorage of an epoch from the issuance of its days
+	if params.BlocksPerEpoch%params.BlocksPerDay != 0 {
+		return fmt.Errorf("calendar blocks per epoch %d should be a multiple of the blocks per day %d",
+			params.BlocksPerEpoch, params.BlocksPerDay)
+	}
+
+	if params.DayDuration <= 0 {
+		return fmt.Errorf("calendar day duration should be positive, is %s", params.DayDuration)
+	}
+
+	if params.EpochDuration <= 0 {
+		return fmt.Errorf("calendar epoch duration should be positive, is %s", params.EpochDuration)
+	}
+
+	if params.EpochDuration%params.DayDuration != 0 {
+		return fmt.Errorf("calendar epoch duration %s should be a multiple of the day duration %s",
+			params.EpochDuration, params.DayDuration)
+	}
+
+	return nil
+}
+
+func (params Params) String() string {
+	return fmt.Sprintf(`calendar Params:
+	Mode: %s
+	BlocksPerDay: %d
+	BlocksPerEpoch: %d
+	DayDuration: %s
+	EpochDuration: %s
+  `, params.Mode, params.BlocksPerDay, params.BlocksPerEpoch, params.DayDuration, params.EpochDuration)
+}
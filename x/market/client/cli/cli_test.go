@@ -65,6 +65,46 @@ func TestQuerySwap(t *testing.T) {
 	require.Equal(t, []string{"true"}, offerCoinFlag.Annotations[cobra.BashCompOneRequiredFlag])
 }
 
+func TestQueryVolume(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryVolumeCmd := GetCmdQueryVolume(cdc)
+
+	// Name check
+	require.Equal(t, market.QueryVolume, queryVolumeCmd.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryVolumeCmd.Args))
+
+	// Check Flags
+	unitFlag := queryVolumeCmd.Flag(flagUnit)
+	require.NotNil(t, unitFlag)
+	require.Equal(t, market.PeriodEpoch, unitFlag.DefValue)
+
+	periodFlag := queryVolumeCmd.Flag(flagPeriod)
+	require.NotNil(t, periodFlag)
+}
+
+func TestQueryStats(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryStatsCmd := GetCmdQueryStats(cdc)
+
+	// Name check
+	require.Equal(t, market.QueryStats, queryStatsCmd.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryStatsCmd.Args))
+
+	// Check Flags
+	unitFlag := queryStatsCmd.Flag(flagUnit)
+	require.NotNil(t, unitFlag)
+	require.Equal(t, market.PeriodEpoch, unitFlag.DefValue)
+
+	periodFlag := queryStatsCmd.Flag(flagPeriod)
+	require.NotNil(t, periodFlag)
+}
+
 func TestQueryParams(t *testing.T) {
 	cdc, _, _, _ := testutil.PrepareCmdTest()
 
@@ -76,3 +116,102 @@ func TestQueryParams(t *testing.T) {
 	// NoArg check
 	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryParamsCmd.Args))
 }
+
+func TestPlaceLimitSwapTx(t *testing.T) {
+	cdc, rootCmd, txCmd, _ := testutil.PrepareCmdTest()
+
+	marketTxCmd := &cobra.Command{
+		Use:   "market",
+		Short: "Market transaction subcommands",
+	}
+
+	txCmd.AddCommand(marketTxCmd)
+
+	marketTxCmd.AddCommand(client.PostCommands(
+		GetPlaceLimitSwapCmd(cdc),
+		GetCancelLimitSwapCmd(cdc),
+	)...)
+
+	// normal case all parameter given
+	_, err := testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`market`,
+		`place-limit-swap`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--offer-coin=1000uluna`,
+		`--ask-denom=ukrw`,
+		`--rate=300.5`,
+		`--expiry=1000`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+
+	// invalid rate
+	_, err = testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`market`,
+		`place-limit-swap`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--offer-coin=1000uluna`,
+		`--ask-denom=ukrw`,
+		`--rate=abc`,
+		`--expiry=1000`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.NotNil(t, err)
+
+	_, err = testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`market`,
+		`cancel-limit-swap`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--order-id=1`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+}
+
+func TestQueryOrders(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryOrdersCmd := GetCmdQueryOrders(cdc)
+
+	// Name check
+	require.Equal(t, market.QueryOrders, queryOrdersCmd.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryOrdersCmd.Args))
+
+	// Check Flags
+	ownerFlag := queryOrdersCmd.Flag(flagOwner)
+	require.NotNil(t, ownerFlag)
+	require.Equal(t, []string{"true"}, ownerFlag.Annotations[cobra.BashCompOneRequiredFlag])
+}
+
+func TestQuerySpreadFees(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	querySpreadFeesCmd := GetCmdQuerySpreadFees(cdc)
+
+	// Name check
+	require.Equal(t, market.QuerySpreadFees, querySpreadFeesCmd.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(querySpreadFeesCmd.Args))
+
+	// Check Flags
+	epochFlag := querySpreadFeesCmd.Flag(flagEpoch)
+	require.NotNil(t, epochFlag)
+}
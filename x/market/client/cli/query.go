@@ -64,6 +64,180 @@ $ terracli query query swap --ask-denom usdr --offer-coin 5000000uluna
 	return cmd
 }
 
+const (
+	flagUnit   = "unit"
+	flagPeriod = "period"
+	flagOwner  = "owner"
+	flagEpoch  = "epoch"
+)
+
+// GetCmdQueryVolume implements the query swap volume command.
+func GetCmdQueryVolume(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   market.QueryVolume,
+		Args:  cobra.NoArgs,
+		Short: "Query the swap volume per (offer, ask) pair for a day or an epoch",
+		Long: strings.TrimSpace(`
+Query the amount of coins offered and returned per (offer, ask) pair in the specified day or epoch.
+
+$ terracli query market volume --unit=epoch --period=14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route, err := periodQueryRoute(market.QueryVolume)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var volume market.QueryVolumeResponse
+			cdc.MustUnmarshalJSON(res, &volume)
+			return cliCtx.PrintOutput(volume)
+		},
+	}
+
+	cmd.Flags().String(flagUnit, market.PeriodEpoch, fmt.Sprintf("the aggregation unit; either %s or %s", market.PeriodDay, market.PeriodEpoch))
+	cmd.Flags().String(flagPeriod, "", "(optional) the day or epoch number to query; default is the current one")
+
+	return cmd
+}
+
+// GetCmdQueryStats implements the query swap statistics command.
+func GetCmdQueryStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   market.QueryStats,
+		Args:  cobra.NoArgs,
+		Short: "Query the swap count and spread fees for a day or an epoch",
+		Long: strings.TrimSpace(`
+Query the number of swaps executed and the spread fees collected in the specified day or epoch.
+
+$ terracli query market stats --unit=day --period=100
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route, err := periodQueryRoute(market.QueryStats)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var stats market.SwapStats
+			cdc.MustUnmarshalJSON(res, &stats)
+			return cliCtx.PrintOutput(stats)
+		},
+	}
+
+	cmd.Flags().String(flagUnit, market.PeriodEpoch, fmt.Sprintf("the aggregation unit; either %s or %s", market.PeriodDay, market.PeriodEpoch))
+	cmd.Flags().String(flagPeriod, "", "(optional) the day or epoch number to query; default is the current one")
+
+	return cmd
+}
+
+// periodQueryRoute builds the query route of a per-period market query from the unit and period flags
+func periodQueryRoute(queryPath string) (string, error) {
+	unit := viper.GetString(flagUnit)
+	if unit != market.PeriodDay && unit != market.PeriodEpoch {
+		return "", fmt.Errorf("the given unit {%s} is not valid; unit should be either %s or %s", unit, market.PeriodDay, market.PeriodEpoch)
+	}
+
+	periodStr := viper.GetString(flagPeriod)
+	if len(periodStr) == 0 {
+		return fmt.Sprintf("custom/%s/%s/%s", market.QuerierRoute, queryPath, unit), nil
+	}
+
+	if _, ok := sdk.NewIntFromString(periodStr); !ok {
+		return "", fmt.Errorf("the given period {%s} is not a valid format; period should be formatted as an integer", periodStr)
+	}
+
+	return fmt.Sprintf("custom/%s/%s/%s/%s", market.QuerierRoute, queryPath, unit, periodStr), nil
+}
+
+// GetCmdQueryOrders implements the query limit orders command.
+func GetCmdQueryOrders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   market.QueryOrders,
+		Args:  cobra.NoArgs,
+		Short: "Query the open limit swap orders of a trader",
+		Long: strings.TrimSpace(`
+Query the open limit swap orders placed by the given owner.
+
+$ terracli query market orders --owner terra1nk5lsuvy0rcfjcdr8au8za0wq25rat0qa07p6t
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(viper.GetString(flagOwner))
+			if err != nil {
+				return err
+			}
+
+			params := market.NewQueryOrdersParams(owner)
+			bz := cdc.MustMarshalJSON(params)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", market.QuerierRoute, market.QueryOrders), bz)
+			if err != nil {
+				return err
+			}
+
+			var orders market.QueryOrdersResponse
+			cdc.MustUnmarshalJSON(res, &orders)
+			return cliCtx.PrintOutput(orders)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "Address of the trader who placed the orders")
+
+	cmd.MarkFlagRequired(flagOwner)
+
+	return cmd
+}
+
+// GetCmdQuerySpreadFees implements the query spread fee distribution command.
+func GetCmdQuerySpreadFees(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   market.QuerySpreadFees,
+		Args:  cobra.NoArgs,
+		Short: "Query where the spread fees of an epoch were sent",
+		Long: strings.TrimSpace(`
+Query the spread fees collected in the epoch, split between oracle rewards, the community pool and the burn.
+
+$ terracli query market spread-fees --epoch=14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			epochStr := viper.GetString(flagEpoch)
+			if len(epochStr) != 0 {
+				if _, ok := sdk.NewIntFromString(epochStr); !ok {
+					return fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				}
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", market.QuerierRoute, market.QuerySpreadFees, epochStr), nil)
+			if err != nil {
+				return err
+			}
+
+			var distribution market.SpreadFeeDistribution
+			cdc.MustUnmarshalJSON(res, &distribution)
+			return cliCtx.PrintOutput(distribution)
+		},
+	}
+
+	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you wants to get spread fees of; default is current epoch")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/terra-project/core/x/market"
@@ -20,6 +21,9 @@ const (
 	flagOfferCoin = "offer-coin"
 	flagAskDenom  = "ask-denom"
 	flagOffline   = "offline"
+	flagRate      = "rate"
+	flagExpiry    = "expiry"
+	flagOrderID   = "order-id"
 )
 
 // GetSwapCmd will create and send a MsgSwap
@@ -91,3 +95,123 @@ $ terracli market swap --offer-coin="1000ukrw" --ask-denom="uusd"
 
 	return cmd
 }
+
+// GetPlaceLimitSwapCmd will create and send a MsgPlaceLimitSwap
+func GetPlaceLimitSwapCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-swap",
+		Short: "Place a limit swap order filled once the oracle rate reaches the target",
+		Long: strings.TrimSpace(`
+Escrow the offer-coin and swap it to the ask-denom currency as soon as the oracle rate returns at least
+the given rate of ask-denom per unit of offer-coin, net of spread. The order is refunded at the expiry height.
+
+$ terracli market place-limit-swap --offer-coin="1000000uluna" --ask-denom="ukrw" --rate="300" --expiry=150000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			askDenom := viper.GetString(flagAskDenom)
+			if len(askDenom) == 0 {
+				return fmt.Errorf("--ask-denom flag is required")
+			}
+
+			offerCoin, err := sdk.ParseCoin(viper.GetString(flagOfferCoin))
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(viper.GetString(flagRate))
+			if err != nil {
+				return fmt.Errorf("given rate {%s} is not a valid format; rate should be formatted as DEC", viper.GetString(flagRate))
+			}
+
+			expiryHeight := viper.GetInt64(flagExpiry)
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			offline := viper.GetBool(flagOffline)
+			if !offline {
+				fromAccount, err := cliCtx.GetAccount(fromAddress)
+				if err != nil {
+					return err
+				}
+
+				if fromAccount.GetCoins().AmountOf(offerCoin.Denom).LT(offerCoin.Amount) {
+					return fmt.Errorf(strings.TrimSpace(`
+						account %s has insufficient amount of coins to pay the offered coins.\n
+						Required: %s\n
+						Given:    %s\n`),
+						fromAddress, offerCoin, fromAccount.GetCoins())
+				}
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := market.NewMsgPlaceLimitSwap(fromAddress, offerCoin, askDenom, rate, expiryHeight)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.Flags().String(flagOfferCoin, "", "The asset to swap from e.g. 1000ukrw")
+	cmd.Flags().String(flagAskDenom, "", "Denom of the asset to swap to")
+	cmd.Flags().String(flagRate, "", "Minimum amount of ask-denom to receive per unit of the offer-coin")
+	cmd.Flags().Int64(flagExpiry, 0, "Block height at which the order is refunded if not filled")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	cmd.MarkFlagRequired(flagOfferCoin)
+	cmd.MarkFlagRequired(flagAskDenom)
+	cmd.MarkFlagRequired(flagRate)
+	cmd.MarkFlagRequired(flagExpiry)
+
+	return cmd
+}
+
+// GetCancelLimitSwapCmd will create and send a MsgCancelLimitSwap
+func GetCancelLimitSwapCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-swap",
+		Short: "Cancel an open limit swap order and refund the escrowed coin",
+		Long: strings.TrimSpace(`
+Cancel an open limit swap order placed by the sender and refund its offer coin.
+
+$ terracli market cancel-limit-swap --order-id=12
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			orderIDStr := viper.GetString(flagOrderID)
+			orderID, err := strconv.ParseUint(orderIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("given order-id {%s} is not a valid format; order-id should be formatted as an unsigned integer", orderIDStr)
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := market.NewMsgCancelLimitSwap(fromAddress, orderID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, viper.GetBool(flagOffline))
+		},
+	}
+
+	cmd.Flags().String(flagOrderID, "", "ID of the limit swap order to cancel")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	cmd.MarkFlagRequired(flagOrderID)
+
+	return cmd
+}
@@ -27,6 +27,10 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	marketQueryCmd.AddCommand(client.GetCommands(
 		cli.GetCmdQuerySwap(mc.cdc),
+		cli.GetCmdQueryVolume(mc.cdc),
+		cli.GetCmdQueryStats(mc.cdc),
+		cli.GetCmdQueryOrders(mc.cdc),
+		cli.GetCmdQuerySpreadFees(mc.cdc),
 		cli.GetCmdQueryParams(mc.cdc),
 	)...)
 
@@ -42,6 +46,8 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 
 	marketTxCmd.AddCommand(client.PostCommands(
 		cli.GetSwapCmd(mc.cdc),
+		cli.GetPlaceLimitSwapCmd(mc.cdc),
+		cli.GetCancelLimitSwapCmd(mc.cdc),
 	)...)
 
 	return marketTxCmd
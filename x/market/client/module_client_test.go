@@ -14,7 +14,9 @@ const (
 
 var (
 	txCmdList = map[string]bool{
-		"swap": true,
+		"swap":              true,
+		"place-limit-swap":  true,
+		"cancel-limit-swap": true,
 	}
 )
 
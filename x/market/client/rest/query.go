@@ -17,6 +17,13 @@ import (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/market/swap", querySwapHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}", market.QueryVolume, RestUnit), queryPeriodHandlerFn(cdc, cliCtx, market.QueryVolume)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}/{%s}", market.QueryVolume, RestUnit, RestPeriod), queryPeriodHandlerFn(cdc, cliCtx, market.QueryVolume)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}", market.QueryStats, RestUnit), queryPeriodHandlerFn(cdc, cliCtx, market.QueryStats)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}/{%s}", market.QueryStats, RestUnit, RestPeriod), queryPeriodHandlerFn(cdc, cliCtx, market.QueryStats)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}", market.QueryOrders, RestOwner), queryOrdersHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s", market.QuerySpreadFees), querySpreadFeesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/market/%s/{%s}", market.QuerySpreadFees, RestEpoch), querySpreadFeesHandlerFn(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc("/market/params", queryParamsHandlerFn(cdc, cliCtx)).Methods("GET")
 }
 
@@ -57,6 +64,83 @@ func querySwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handle
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// queryPeriodHandlerFn serves the per-day and per-epoch market queries; the period defaults to the current one
+func queryPeriodHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, queryPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		unit := vars[RestUnit]
+		periodStr := vars[RestPeriod]
+
+		if unit != market.PeriodDay && unit != market.PeriodEpoch {
+			err := fmt.Errorf("the given unit {%s} is not valid; unit should be either %s or %s", unit, market.PeriodDay, market.PeriodEpoch)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if len(periodStr) != 0 {
+			if _, ok := sdk.NewIntFromString(periodStr); !ok {
+				err := fmt.Errorf("the given period {%s} is not a valid format; period should be formatted as an integer", periodStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", market.QuerierRoute, queryPath, unit, periodStr), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryOrdersHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		owner, err := sdk.AccAddressFromBech32(vars[RestOwner])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := market.NewQueryOrdersParams(owner)
+		bz := cdc.MustMarshalJSON(params)
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", market.QuerierRoute, market.QueryOrders), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func querySpreadFeesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		epochStr := vars[RestEpoch]
+
+		if len(epochStr) != 0 {
+			if _, ok := sdk.NewIntFromString(epochStr); !ok {
+				err := fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", market.QuerierRoute, market.QuerySpreadFees, epochStr), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -6,6 +6,16 @@ import (
 	"github.com/gorilla/mux"
 )
 
+// REST Variable names
+// nolint
+const (
+	RestUnit    = "unit"
+	RestPeriod  = "period"
+	RestOwner   = "owner"
+	RestOrderID = "order_id"
+	RestEpoch   = "epoch"
+)
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	registerTxRoutes(cliCtx, r, cdc)
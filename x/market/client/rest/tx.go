@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/terra-project/core/x/market"
@@ -19,6 +20,8 @@ import (
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc("/market/swap", submitSwapHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc("/market/limit_orders", submitPlaceLimitSwapHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/market/limit_orders/{%s}/cancel", RestOrderID), submitCancelLimitSwapHandlerFn(cdc, cliCtx)).Methods("POST")
 }
 
 //nolint
@@ -78,3 +81,96 @@ func submitSwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Handl
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+//nolint
+type PlaceLimitSwapReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	OfferCoin    sdk.Coin     `json:"offer_coin"`
+	AskDenom     string       `json:"ask_denom"`
+	TargetRate   sdk.Dec      `json:"target_rate"`
+	ExpiryHeight int64        `json:"expiry_height"`
+}
+
+// submitPlaceLimitSwapHandlerFn handles a POST limit swap request
+func submitPlaceLimitSwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlaceLimitSwapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := market.NewMsgPlaceLimitSwap(fromAddress, req.OfferCoin, req.AskDenom, req.TargetRate, req.ExpiryHeight)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type CancelLimitSwapReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+// submitCancelLimitSwapHandlerFn handles a POST limit swap cancellation request
+func submitCancelLimitSwapHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		orderIDStr := vars[RestOrderID]
+
+		orderID, err := strconv.ParseUint(orderIDStr, 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CancelLimitSwapReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := market.NewMsgCancelLimitSwap(fromAddress, orderID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -9,6 +9,8 @@ var msgCdc = codec.New()
 // RegisterCodec concretes types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSwap{}, "market/MsgSwap", nil)
+	cdc.RegisterConcrete(MsgPlaceLimitSwap{}, "market/MsgPlaceLimitSwap", nil)
+	cdc.RegisterConcrete(MsgCancelLimitSwap{}, "market/MsgCancelLimitSwap", nil)
 }
 
 func init() {
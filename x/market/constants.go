@@ -15,4 +15,7 @@ const (
 
 	// DefaultParamspace is for the paramspace notation
 	DefaultParamspace = ModuleName
+
+	// MaxLimitOrderFills is the most triggered limit orders the EndBlocker tries to fill in a block
+	MaxLimitOrderFills = 100
 )
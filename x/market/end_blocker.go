@@ -0,0 +1,117 @@
+package market
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/terra-project/core/x/market/tags"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker is called at the end of every block, right after the oracle has updated the
+// exchange rates. Swaps queued during the block are settled first at the new rates. Then expired
+// limit orders are refunded, and the orders whose target rate is reached by the new rates are
+// filled in the order they were placed, up to MaxLimitOrderFills orders per block.
+func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	resTags = settleQueuedSwaps(ctx, k)
+
+	var expired []uint64
+	k.iterateExpiredLimitOrders(ctx, ctx.BlockHeight(), func(orderID uint64) (stop bool) {
+		expired = append(expired, orderID)
+		return false
+	})
+
+	for _, orderID := range expired {
+		order, err := k.GetLimitOrder(ctx, orderID)
+		if err != nil {
+			continue
+		}
+
+		if err := k.refundLimitOrder(ctx, order); err != nil {
+			continue
+		}
+
+		resTags = resTags.AppendTags(
+			sdk.NewTags(
+				tags.Action, tags.ActionLimitOrderExpired,
+				tags.OrderID, strconv.FormatUint(order.OrderID, 10),
+				tags.Trader, order.Owner.String(),
+			),
+		)
+	}
+
+	// Only orders whose target rate is reached after the minimum spread can be filled. At most
+	// MaxLimitOrderFills of them are tried in a block; the rest wait for the next one.
+	var triggered []uint64
+	k.iterateTriggeredLimitOrders(ctx, func(orderID uint64) (stop bool) {
+		triggered = append(triggered, orderID)
+		return len(triggered) >= MaxLimitOrderFills
+	})
+	sort.Slice(triggered, func(i, j int) bool { return triggered[i] < triggered[j] })
+
+	for _, orderID := range triggered {
+		order, err := k.GetLimitOrder(ctx, orderID)
+		if err != nil {
+			continue
+		}
+
+		// Fill in a cached context so that a failing swap leaves no partial state behind
+		cacheCtx, writeCache := ctx.CacheContext()
+		_, _, filled, err := k.fillLimitOrder(cacheCtx, order)
+		if err != nil || !filled {
+			continue
+		}
+
+		writeCache()
+
+		resTags = resTags.AppendTags(
+			sdk.NewTags(
+				tags.Action, tags.ActionLimitOrderFilled,
+				tags.OrderID, strconv.FormatUint(order.OrderID, 10),
+				tags.Trader, order.Owner.String(),
+			),
+		)
+	}
+
+	return
+}
+
+// settleQueuedSwaps settles the swaps queued during the block in the order they were submitted.
+// Swaps that can no longer be executed, e.g. because the ask denom lost its price or the daily
+// Luna swap limit was reached, are refunded.
+func settleQueuedSwaps(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	resTags = sdk.EmptyTags()
+
+	var swaps []QueuedSwap
+	k.IterateQueuedSwaps(ctx, func(swap QueuedSwap) (stop bool) {
+		swaps = append(swaps, swap)
+		return false
+	})
+
+	for _, swap := range swaps {
+		action := tags.ActionSwapSettled
+
+		// Settle in a cached context so that a failing swap leaves no partial state behind
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, _, err := k.settleQueuedSwap(cacheCtx, swap); err == nil {
+			writeCache()
+		} else {
+			if err := k.refundQueuedSwap(ctx, swap); err != nil {
+				continue
+			}
+
+			action = tags.ActionSwapRefunded
+		}
+
+		resTags = resTags.AppendTags(
+			sdk.NewTags(
+				tags.Action, action,
+				tags.OrderID, strconv.FormatUint(swap.SwapID, 10),
+				tags.Trader, swap.Trader.String(),
+			),
+		)
+	}
+
+	return
+}
@@ -0,0 +1,189 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHandlerLimitSwapPlaceAndCancel(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	msg := NewMsgPlaceLimitSwap(addrs[0], offerCoin, assets.MicroKRWDenom, sdk.NewDec(1000), 100)
+
+	res := handler(input.ctx, msg)
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	// Offer coin is escrowed without changing the issuance
+	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, uSDRAmt.Sub(offerCoin.Amount), trader.GetCoins().AmountOf(offerCoin.Denom))
+	require.Equal(t, offerCoin.Amount, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).AmountOf(offerCoin.Denom))
+	require.Equal(t, uSDRAmt.MulRaw(int64(len(addrs))), input.mintKeeper.GetIssuance(input.ctx, offerCoin.Denom, sdk.ZeroInt()))
+
+	orders := input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(1), orders[0].OrderID)
+
+	// Only the owner can cancel
+	res = handler(input.ctx, NewMsgCancelLimitSwap(addrs[1], orders[0].OrderID))
+	require.False(t, res.IsOK(), "expected failed message execution: %v", res.Log)
+
+	res = handler(input.ctx, NewMsgCancelLimitSwap(addrs[0], orders[0].OrderID))
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	trader = input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, uSDRAmt, trader.GetCoins().AmountOf(offerCoin.Denom))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).IsZero())
+	require.Equal(t, 0, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+
+	// Already cancelled
+	res = handler(input.ctx, NewMsgCancelLimitSwap(addrs[0], orders[0].OrderID))
+	require.False(t, res.IsOK(), "expected failed message execution: %v", res.Log)
+
+	// Expiry in the past
+	input.ctx = input.ctx.WithBlockHeight(100)
+	res = handler(input.ctx, msg)
+	require.False(t, res.IsOK(), "expected failed message execution: %v", res.Log)
+}
+
+func TestEndBlockerLimitSwapFill(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	msg := NewMsgPlaceLimitSwap(addrs[0], offerCoin, assets.MicroKRWDenom, sdk.NewDec(1000), 100)
+
+	res := handler(input.ctx, msg)
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	// No price yet; order stays open
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 1, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+
+	// Rate below the target; order stays open
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(999))
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 1, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+
+	// Rate reaches the target; order is filled
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1200))
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 0, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+
+	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, sdk.NewInt(1200000), trader.GetCoins().AmountOf(assets.MicroKRWDenom))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).IsZero())
+	require.Equal(t, int64(1), input.marketKeeper.GetSwapStats(input.ctx, PeriodDay, sdk.ZeroInt()).SwapCount)
+}
+
+func TestEndBlockerLimitSwapTriggeredOnly(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1200))
+
+	// Placed first, but its target is above the rate
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	res := handler(input.ctx, NewMsgPlaceLimitSwap(addrs[0], offerCoin, assets.MicroKRWDenom, sdk.NewDec(1300), 100))
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	res = handler(input.ctx, NewMsgPlaceLimitSwap(addrs[1], offerCoin, assets.MicroKRWDenom, sdk.NewDec(1000), 100))
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 1, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+	require.Equal(t, 0, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[1])))
+	require.Equal(t, offerCoin.Amount, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).AmountOf(offerCoin.Denom))
+
+	// Rate reaches the remaining target
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1400))
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 0, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).IsZero())
+}
+
+func TestEndBlockerLimitSwapExpiry(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	msg := NewMsgPlaceLimitSwap(addrs[0], offerCoin, assets.MicroKRWDenom, sdk.NewDec(1000), 10)
+
+	res := handler(input.ctx, msg)
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	input.ctx = input.ctx.WithBlockHeight(10)
+	EndBlocker(input.ctx, input.marketKeeper)
+	require.Equal(t, 0, len(input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])))
+
+	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, uSDRAmt, trader.GetCoins().AmountOf(offerCoin.Denom))
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).IsZero())
+}
+
+func TestEndBlockerDeferredSwap(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	params := input.marketKeeper.GetParams(input.ctx)
+	params.DeferredSwaps = true
+	input.marketKeeper.SetParams(input.ctx, params)
+
+	// Stale rate at the time the swap is submitted
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1000))
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	res := handler(input.ctx, NewMsgSwap(addrs[0], offerCoin, assets.MicroKRWDenom))
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+	require.Equal(t, []byte("1"), res.Data)
+
+	_, err := input.marketKeeper.GetQueuedSwap(input.ctx, 1)
+	require.Nil(t, err)
+
+	// Offer coin is escrowed, nothing is credited yet
+	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, uSDRAmt.Sub(offerCoin.Amount), trader.GetCoins().AmountOf(offerCoin.Denom))
+	require.True(t, trader.GetCoins().AmountOf(assets.MicroKRWDenom).IsZero())
+	require.Equal(t, offerCoin.Amount, input.bankKeeper.GetCoins(input.ctx, EscrowAddress).AmountOf(offerCoin.Denom))
+
+	// Oracle publishes a new rate before the market settles
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1100))
+	EndBlocker(input.ctx, input.marketKeeper)
+
+	trader = input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, sdk.NewInt(1100000), trader.GetCoins().AmountOf(assets.MicroKRWDenom))
+
+	_, err = input.marketKeeper.GetQueuedSwap(input.ctx, 1)
+	require.NotNil(t, err)
+}
+
+func TestEndBlockerDeferredSwapRefund(t *testing.T) {
+	input := createTestInput(t)
+	handler := NewHandler(input.marketKeeper)
+
+	params := input.marketKeeper.GetParams(input.ctx)
+	params.DeferredSwaps = true
+	input.marketKeeper.SetParams(input.ctx, params)
+
+	// No price for the ask denom; the swap is refunded at the end of the block
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	res := handler(input.ctx, NewMsgSwap(addrs[0], offerCoin, assets.MicroKRWDenom))
+	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
+
+	EndBlocker(input.ctx, input.marketKeeper)
+
+	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, uSDRAmt, trader.GetCoins().AmountOf(offerCoin.Denom))
+
+	_, err := input.marketKeeper.GetQueuedSwap(input.ctx, 1)
+	require.NotNil(t, err)
+}
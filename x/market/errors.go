@@ -1,6 +1,8 @@
 package market
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +14,10 @@ const (
 	CodeNoEffectivePrice sdk.CodeType = 2
 	CodeRecursiveSwap    sdk.CodeType = 3
 	CodeExceedsSwapLimit sdk.CodeType = 4
+	CodeOrderNotFound    sdk.CodeType = 5
+	CodeNotOrderOwner    sdk.CodeType = 6
+	CodeInvalidExpiry    sdk.CodeType = 7
+	CodeInvalidRate      sdk.CodeType = 8
 )
 
 // ----------------------------------------
@@ -36,3 +42,23 @@ func ErrRecursiveSwap(codespace sdk.CodespaceType, denom string) sdk.Error {
 func ErrExceedsDailySwapLimit(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeExceedsSwapLimit, "Exceeded the daily swap limit for Luna")
 }
+
+// ErrOrderNotFound called when the limit order with the given id does not exist
+func ErrOrderNotFound(codespace sdk.CodespaceType, orderID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeOrderNotFound, fmt.Sprintf("Limit order %d not found", orderID))
+}
+
+// ErrNotOrderOwner called when a trader tries to cancel a limit order placed by someone else
+func ErrNotOrderOwner(codespace sdk.CodespaceType, orderID uint64, trader sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotOrderOwner, fmt.Sprintf("Limit order %d is not owned by %s", orderID, trader))
+}
+
+// ErrInvalidExpiry called when the expiry height of a limit order is not in the future
+func ErrInvalidExpiry(codespace sdk.CodespaceType, expiryHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiry, fmt.Sprintf("Limit order expiry height should be in the future, is %d", expiryHeight))
+}
+
+// ErrInvalidTargetRate called when the target rate of a limit order is not positive
+func ErrInvalidTargetRate(codespace sdk.CodespaceType, rate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRate, "Limit order target rate should be positive, is "+rate.String())
+}
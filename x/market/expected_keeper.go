@@ -1,6 +1,9 @@
 package market
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+)
 
 // expected oracle keeper
 type OracleKeeper interface {
@@ -13,4 +16,23 @@ type MintKeeper interface {
 	Mint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
 	Burn(ctx sdk.Context, payer sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
 	GetIssuance(ctx sdk.Context, denom string, day sdk.Int) (issuance sdk.Int)
+	ChangeIssuance(ctx sdk.Context, denom string, delta sdk.Int) (err sdk.Error)
+	ChangeNotBondedTokens(ctx sdk.Context, delta sdk.Int)
+}
+
+// expected bank keeper
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error)
+}
+
+// expected distribution keeper
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distr.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distr.FeePool)
+}
+
+// expected calendar keeper
+type CalendarKeeper interface {
+	GetDay(ctx sdk.Context) sdk.Int
+	GetEpoch(ctx sdk.Context) sdk.Int
 }
@@ -1,41 +1,145 @@
 package market
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
-	Params Params `json:"params"` // market params
+	Params      Params        `json:"params"`       // market params
+	SwapVolumes SwapVolumes   `json:"swap_volumes"` // swap volumes per day and epoch
+	SwapStats   SwapStatsList `json:"swap_stats"`   // swap counts and spread fees per day and epoch
+	LimitOrders LimitOrders   `json:"limit_orders"` // open limit swap orders
+
+	SpreadFeeDistributions SpreadFeeDistributions `json:"spread_fee_distributions"` // destinations of the spread fees per epoch
 }
 
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, swapVolumes SwapVolumes, swapStats SwapStatsList, limitOrders LimitOrders,
+	spreadFeeDistributions SpreadFeeDistributions) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:                 params,
+		SwapVolumes:            swapVolumes,
+		SwapStats:              swapStats,
+		LimitOrders:            limitOrders,
+		SpreadFeeDistributions: spreadFeeDistributions,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params: DefaultParams(),
+		Params:      DefaultParams(),
+		SwapVolumes: SwapVolumes{},
+		SwapStats:   SwapStatsList{},
+		LimitOrders: LimitOrders{},
+
+		SpreadFeeDistributions: SpreadFeeDistributions{},
 	}
 }
 
 // new oracle genesis
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	for _, volume := range data.SwapVolumes {
+		keeper.SetSwapVolume(ctx, volume)
+	}
+
+	for _, stats := range data.SwapStats {
+		keeper.SetSwapStats(ctx, stats)
+	}
+
+	// Resume order ids after the highest imported one
+	var lastOrderID uint64
+	for _, order := range data.LimitOrders {
+		keeper.SetLimitOrder(ctx, order)
+		if order.OrderID > lastOrderID {
+			lastOrderID = order.OrderID
+		}
+	}
+
+	if lastOrderID > 0 {
+		keeper.setLastOrderID(ctx, lastOrderID)
+	}
+
+	for _, distribution := range data.SpreadFeeDistributions {
+		keeper.SetSpreadFeeDistribution(ctx, distribution)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper. The
 // GenesisState will contain the pool, and validator/delegator distribution info's
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(params)
+
+	swapVolumes := SwapVolumes{}
+	keeper.IterateSwapVolumes(ctx, func(volume SwapVolume) (stop bool) {
+		swapVolumes = append(swapVolumes, volume)
+		return false
+	})
+
+	swapStats := SwapStatsList{}
+	keeper.IterateSwapStats(ctx, func(stats SwapStats) (stop bool) {
+		swapStats = append(swapStats, stats)
+		return false
+	})
+
+	limitOrders := LimitOrders{}
+	keeper.IterateLimitOrders(ctx, func(order LimitOrder) (stop bool) {
+		limitOrders = append(limitOrders, order)
+		return false
+	})
+
+	spreadFeeDistributions := SpreadFeeDistributions{}
+	keeper.IterateSpreadFeeDistributions(ctx, func(distribution SpreadFeeDistribution) (stop bool) {
+		spreadFeeDistributions = append(spreadFeeDistributions, distribution)
+		return false
+	})
+
+	return NewGenesisState(params, swapVolumes, swapStats, limitOrders, spreadFeeDistributions)
 }
 
 // ValidateGenesis validates the provided oracle genesis state to ensure the
 // expected invariants holds. (i.e. params in correct bounds, no duplicate validators)
 func ValidateGenesis(data GenesisState) error {
+	for _, volume := range data.SwapVolumes {
+		if !isValidPeriodUnit(volume.Unit) {
+			return fmt.Errorf("Invalid swap volume period unit: %s", volume.Unit)
+		}
+		if volume.Period.IsNegative() || volume.OfferAmount.IsNegative() || volume.AskAmount.IsNegative() {
+			return fmt.Errorf("Invalid swap volume: %s", volume)
+		}
+	}
+
+	for _, stats := range data.SwapStats {
+		if !isValidPeriodUnit(stats.Unit) {
+			return fmt.Errorf("Invalid swap stats period unit: %s", stats.Unit)
+		}
+		if stats.Period.IsNegative() || stats.SwapCount < 0 || !stats.SpreadFees.IsValid() {
+			return fmt.Errorf("Invalid swap stats: %s", stats)
+		}
+	}
+
+	orderMap := make(map[uint64]bool)
+	for _, order := range data.LimitOrders {
+		if _, ok := orderMap[order.OrderID]; ok || order.OrderID == 0 {
+			return fmt.Errorf("Invalid or duplicate limit order id: %d", order.OrderID)
+		}
+		orderMap[order.OrderID] = true
+
+		if order.Owner.Empty() || !order.OfferCoin.IsPositive() || order.OfferCoin.Denom == order.AskDenom || !order.TargetRate.IsPositive() {
+			return fmt.Errorf("Invalid limit order: %s", order)
+		}
+	}
+
+	for _, distribution := range data.SpreadFeeDistributions {
+		if distribution.Epoch.IsNegative() || !distribution.OracleRewards.IsValid() ||
+			!distribution.CommunityPool.IsValid() || !distribution.Burned.IsValid() {
+			return fmt.Errorf("Invalid spread fee distribution: %s", distribution)
+		}
+	}
+
 	return validateParams(data.Params)
 }
@@ -2,6 +2,7 @@ package market
 
 import (
 	"reflect"
+	"strconv"
 
 	"github.com/terra-project/core/x/market/tags"
 
@@ -14,6 +15,10 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSwap:
 			return handleMsgSwap(ctx, k, msg)
+		case MsgPlaceLimitSwap:
+			return handleMsgPlaceLimitSwap(ctx, k, msg)
+		case MsgCancelLimitSwap:
+			return handleMsgCancelLimitSwap(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized market Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -29,23 +34,19 @@ func handleMsgSwap(ctx sdk.Context, k Keeper, msg MsgSwap) sdk.Result {
 		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom).Result()
 	}
 
+	// In deferred mode, the swap is settled at the end of the block with the freshly published oracle rates
+	if k.GetParams(ctx).DeferredSwaps {
+		return queueMsgSwap(ctx, k, msg)
+	}
+
 	// Compute exchange rates between the ask and offer
 	swapCoin, spread, swapErr := k.GetSwapCoin(ctx, msg.OfferCoin, msg.AskDenom, false)
 	if swapErr != nil {
 		return swapErr.Result()
 	}
 
-	// Charge a spread if applicable; distributed to vote winners in the oracle module
-	swapFee := sdk.Coin{}
-	if spread.IsPositive() {
-		swapFeeAmt := spread.MulInt(swapCoin.Amount).TruncateInt()
-		if swapFeeAmt.IsPositive() {
-			swapFee = sdk.NewCoin(swapCoin.Denom, swapFeeAmt)
-			k.ok.AddSwapFeePool(ctx, sdk.NewCoins(swapFee))
-
-			swapCoin = swapCoin.Sub(swapFee)
-		}
-	}
+	// Charge a spread if applicable; split between oracle vote winners, the community pool and the burn
+	swapCoin, spreadFees := computeSpreadFee(swapCoin, spread)
 
 	// Burn offered coins and subtract from the trader's account
 	burnErr := k.mk.Burn(ctx, msg.Trader, msg.OfferCoin)
@@ -54,14 +55,14 @@ func handleMsgSwap(ctx sdk.Context, k Keeper, msg MsgSwap) sdk.Result {
 	}
 
 	// Mint asked coins and credit Trader's account
-	mintErr := k.mk.Mint(ctx, msg.Trader, swapCoin)
-	if mintErr != nil {
-		return mintErr.Result()
+	creditErr := k.creditSwap(ctx, msg.Trader, msg.OfferCoin, swapCoin, spreadFees)
+	if creditErr != nil {
+		return creditErr.Result()
 	}
 
 	log := NewLog()
 	log = log.append(LogKeySwapCoin, swapCoin.String())
-	log = log.append(LogKeySwapFee, swapFee.String())
+	log = log.append(LogKeySwapFee, spreadFees.String())
 
 	return sdk.Result{
 		Tags: sdk.NewTags(
@@ -71,3 +72,78 @@ func handleMsgSwap(ctx sdk.Context, k Keeper, msg MsgSwap) sdk.Result {
 		Log: log.String(),
 	}
 }
+
+// queueMsgSwap escrows the offered coins of a MsgSwap and queues it for settlement in the EndBlocker
+func queueMsgSwap(ctx sdk.Context, k Keeper, msg MsgSwap) sdk.Result {
+	swapID, err := k.QueueSwap(ctx, msg.Trader, msg.OfferCoin, msg.AskDenom)
+	if err != nil {
+		return err.Result()
+	}
+
+	log := NewLog()
+	log = log.append(LogKeyQueuedSwapID, strconv.FormatUint(swapID, 10))
+
+	return sdk.Result{
+		Data: []byte(strconv.FormatUint(swapID, 10)),
+		Tags: sdk.NewTags(
+			tags.OrderID, strconv.FormatUint(swapID, 10),
+			tags.Offer, msg.OfferCoin.Denom,
+			tags.Trader, msg.Trader.String(),
+		),
+		Log: log.String(),
+	}
+}
+
+// handleMsgPlaceLimitSwap handles the logic of a MsgPlaceLimitSwap
+func handleMsgPlaceLimitSwap(ctx sdk.Context, k Keeper, msg MsgPlaceLimitSwap) sdk.Result {
+
+	// Can't swap to the same coin
+	if msg.OfferCoin.Denom == msg.AskDenom {
+		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom).Result()
+	}
+
+	if msg.ExpiryHeight <= ctx.BlockHeight() {
+		return ErrInvalidExpiry(DefaultCodespace, msg.ExpiryHeight).Result()
+	}
+
+	// Escrow the offered coins until the order is filled, cancelled or expires
+	escrowErr := k.escrow(ctx, msg.Trader, msg.OfferCoin)
+	if escrowErr != nil {
+		return escrowErr.Result()
+	}
+
+	orderID := k.NewOrderID(ctx)
+	k.SetLimitOrder(ctx, NewLimitOrder(orderID, msg.Trader, msg.OfferCoin, msg.AskDenom, msg.TargetRate, msg.ExpiryHeight))
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.OrderID, strconv.FormatUint(orderID, 10),
+			tags.Offer, msg.OfferCoin.Denom,
+			tags.Trader, msg.Trader.String(),
+		),
+	}
+}
+
+// handleMsgCancelLimitSwap handles the logic of a MsgCancelLimitSwap
+func handleMsgCancelLimitSwap(ctx sdk.Context, k Keeper, msg MsgCancelLimitSwap) sdk.Result {
+	order, err := k.GetLimitOrder(ctx, msg.OrderID)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !order.Owner.Equals(msg.Trader) {
+		return ErrNotOrderOwner(DefaultCodespace, msg.OrderID, msg.Trader).Result()
+	}
+
+	err = k.refundLimitOrder(ctx, order)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.OrderID, strconv.FormatUint(msg.OrderID, 10),
+			tags.Trader, msg.Trader.String(),
+		),
+	}
+}
@@ -41,6 +41,12 @@ func TestHandlerMsgSwapValidPrice(t *testing.T) {
 	trader := input.accKeeper.GetAccount(input.ctx, addrs[0])
 	require.Equal(t, trader.GetCoins().AmountOf(offerCoin.Denom), uSDRAmt.Sub(offerCoin.Amount))
 	require.Equal(t, trader.GetCoins().AmountOf(askCoin.Denom), retAmt)
+
+	// Swap is recorded in the market statistics
+	volume := input.marketKeeper.GetSwapVolume(input.ctx, PeriodEpoch, input.calendarKeeper.GetEpoch(input.ctx), offerCoin.Denom, askCoin.Denom)
+	require.Equal(t, offerCoin.Amount, volume.OfferAmount)
+	require.Equal(t, retAmt, volume.AskAmount)
+	require.Equal(t, int64(1), input.marketKeeper.GetSwapStats(input.ctx, PeriodDay, sdk.ZeroInt()).SwapCount)
 }
 
 func TestHandlerMsgSwapNoBalance(t *testing.T) {
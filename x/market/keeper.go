@@ -2,45 +2,56 @@ package market
 
 import (
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/tendermint/tendermint/crypto"
 )
 
+// EscrowAddress holds the offer coins of queued swaps and open limit orders until they are settled
+var EscrowAddress = sdk.AccAddress(crypto.AddressHash([]byte("market_escrow")))
+
 // Keeper holds data structures for the market module
 type Keeper struct {
 	cdc        *codec.Codec // Codec to encore/decode structs
 	key        sdk.StoreKey // Key to our module's store
 	ok         OracleKeeper
 	mk         MintKeeper
+	bk         BankKeeper
+	dk         DistributionKeeper
+	ck         CalendarKeeper
 	paramSpace params.Subspace
 }
 
 // NewKeeper creates a new Keeper for the market module
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ok OracleKeeper, mk MintKeeper, paramspace params.Subspace) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ok OracleKeeper, mk MintKeeper, bk BankKeeper,
+	dk DistributionKeeper, ck CalendarKeeper, paramspace params.Subspace) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		key:        key,
 		ok:         ok,
 		mk:         mk,
+		bk:         bk,
+		dk:         dk,
+		ck:         ck,
 		paramSpace: paramspace.WithKeyTable(paramKeyTable()),
 	}
 }
 
 // ComputeLunaDelta returns the issuance rate change of Luna for the day post-swap
 func (k Keeper) ComputeLunaDelta(ctx sdk.Context, change sdk.Int) sdk.Dec {
-	curDay := ctx.BlockHeight() / util.BlocksPerDay
+	curDay := k.ck.GetDay(ctx)
 
 	// Start limits on day 2
-	if curDay == 0 {
+	if curDay.IsZero() {
 		return sdk.ZeroDec()
 	}
 
-	prevIssuance := k.mk.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(curDay-1))
+	prevIssuance := k.mk.GetIssuance(ctx, assets.MicroLunaDenom, curDay.Sub(sdk.OneInt()))
 	if !prevIssuance.IsZero() {
-		curIssuance := k.mk.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(curDay))
+		curIssuance := k.mk.GetIssuance(ctx, assets.MicroLunaDenom, curDay)
 		postSwapIssuance := curIssuance.Add(change)
 
 		return sdk.NewDecFromInt(postSwapIssuance.Sub(prevIssuance)).QuoInt(prevIssuance)
@@ -122,6 +133,519 @@ func (k Keeper) GetSwapDecCoin(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom
 	return sdk.NewDecCoinFromDec(askDenom, retAmount), nil
 }
 
+//-----------------------------------
+// Swap volume logic
+
+// GetSwapVolume returns the swap volume of the (offer, ask) pair for the given day or epoch
+func (k Keeper) GetSwapVolume(ctx sdk.Context, unit string, period sdk.Int, offerDenom, askDenom string) (volume SwapVolume) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keySwapVolume(unit, period, offerDenom, askDenom))
+	if bz == nil {
+		return NewSwapVolume(unit, period, offerDenom, askDenom, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &volume)
+	return
+}
+
+// SetSwapVolume stores the swap volume of an (offer, ask) pair
+func (k Keeper) SetSwapVolume(ctx sdk.Context, volume SwapVolume) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(volume)
+	store.Set(keySwapVolume(volume.Unit, volume.Period, volume.OfferDenom, volume.AskDenom), bz)
+}
+
+// GetSwapVolumes returns the swap volumes of all pairs traded in the given day or epoch
+func (k Keeper) GetSwapVolumes(ctx sdk.Context, unit string, period sdk.Int) (volumes SwapVolumes) {
+	volumes = SwapVolumes{}
+	k.iterateSwapVolumesWithPrefix(ctx, prefixSwapVolumeForPeriod(unit, period), func(volume SwapVolume) (stop bool) {
+		volumes = append(volumes, volume)
+		return false
+	})
+
+	return
+}
+
+// IterateSwapVolumes iterates over all the swap volumes in the store
+func (k Keeper) IterateSwapVolumes(ctx sdk.Context, handler func(volume SwapVolume) (stop bool)) {
+	k.iterateSwapVolumesWithPrefix(ctx, prefixSwapVolume, handler)
+}
+
+func (k Keeper) iterateSwapVolumesWithPrefix(ctx sdk.Context, prefix []byte, handler func(volume SwapVolume) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var volume SwapVolume
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &volume)
+		if handler(volume) {
+			break
+		}
+	}
+}
+
+// GetSwapStats returns the swap count and spread fees collected in the given day or epoch
+func (k Keeper) GetSwapStats(ctx sdk.Context, unit string, period sdk.Int) (stats SwapStats) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keySwapStats(unit, period))
+	if bz == nil {
+		return NewSwapStats(unit, period, 0, sdk.Coins{})
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stats)
+	return
+}
+
+// SetSwapStats stores the swap statistics of a day or epoch
+func (k Keeper) SetSwapStats(ctx sdk.Context, stats SwapStats) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(stats)
+	store.Set(keySwapStats(stats.Unit, stats.Period), bz)
+}
+
+// IterateSwapStats iterates over all the swap statistics in the store
+func (k Keeper) IterateSwapStats(ctx sdk.Context, handler func(stats SwapStats) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixSwapStats)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stats SwapStats
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stats)
+		if handler(stats) {
+			break
+		}
+	}
+}
+
+// RecordSwap adds a settled swap to the volume and statistics of the current day and epoch
+func (k Keeper) RecordSwap(ctx sdk.Context, offerCoin sdk.Coin, swapCoin sdk.Coin, spreadFees sdk.Coins) {
+	for _, unit := range []string{PeriodDay, PeriodEpoch} {
+		period := k.currentPeriod(ctx, unit)
+
+		volume := k.GetSwapVolume(ctx, unit, period, offerCoin.Denom, swapCoin.Denom)
+		volume.OfferAmount = volume.OfferAmount.Add(offerCoin.Amount)
+		volume.AskAmount = volume.AskAmount.Add(swapCoin.Amount)
+		k.SetSwapVolume(ctx, volume)
+
+		stats := k.GetSwapStats(ctx, unit, period)
+		stats.SwapCount++
+		stats.SpreadFees = stats.SpreadFees.Add(spreadFees)
+		k.SetSwapStats(ctx, stats)
+	}
+}
+
+// creditSwap completes a swap whose offer coin has already been burned: the spread fees are
+// collected, the asked coin is minted to the trader and the swap is recorded in the market statistics.
+func (k Keeper) creditSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin, swapCoin sdk.Coin, spreadFees sdk.Coins) sdk.Error {
+	err := k.collectSpreadFees(ctx, spreadFees)
+	if err != nil {
+		return err
+	}
+
+	err = k.mk.Mint(ctx, trader, swapCoin)
+	if err != nil {
+		return err
+	}
+
+	k.RecordSwap(ctx, offerCoin, swapCoin, spreadFees)
+	return nil
+}
+
+//-----------------------------------
+// Escrow logic
+
+// escrow moves the offer coin of a pending swap from the trader to the escrow account. The coin
+// stays in the issuance until the swap burns it, so pending swaps do not move the daily Luna delta.
+func (k Keeper) escrow(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin) sdk.Error {
+	_, err := k.bk.SendCoins(ctx, trader, EscrowAddress, sdk.Coins{offerCoin})
+	return err
+}
+
+// releaseEscrow returns the offer coin of a pending swap from the escrow account to the trader
+func (k Keeper) releaseEscrow(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin) sdk.Error {
+	_, err := k.bk.SendCoins(ctx, EscrowAddress, trader, sdk.Coins{offerCoin})
+	return err
+}
+
+// settleEscrow burns the escrowed offer coin of a pending swap and credits the asked coin to the trader
+func (k Keeper) settleEscrow(ctx sdk.Context, trader sdk.AccAddress, offerCoin, swapCoin sdk.Coin, spreadFees sdk.Coins) sdk.Error {
+	err := k.mk.Burn(ctx, EscrowAddress, offerCoin)
+	if err != nil {
+		return err
+	}
+
+	return k.creditSwap(ctx, trader, offerCoin, swapCoin, spreadFees)
+}
+
+//-----------------------------------
+// Queued swap logic
+
+// QueueSwap escrows the offer coin of a swap and queues it to be settled at the end of the block,
+// once the oracle has published the new exchange rates. Returns the id of the queued swap.
+func (k Keeper) QueueSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) (swapID uint64, err sdk.Error) {
+	err = k.escrow(ctx, trader, offerCoin)
+	if err != nil {
+		return
+	}
+
+	// Queued swaps share the id sequence of limit orders
+	swapID = k.NewOrderID(ctx)
+	k.SetQueuedSwap(ctx, NewQueuedSwap(swapID, trader, offerCoin, askDenom, ctx.BlockHeight()))
+	return
+}
+
+// GetQueuedSwap gets the queued swap with the given id from the store
+func (k Keeper) GetQueuedSwap(ctx sdk.Context, swapID uint64) (swap QueuedSwap, err sdk.Error) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyQueuedSwap(swapID)); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &swap)
+	} else {
+		err = ErrOrderNotFound(DefaultCodespace, swapID)
+	}
+	return
+}
+
+// SetQueuedSwap stores a queued swap
+func (k Keeper) SetQueuedSwap(ctx sdk.Context, swap QueuedSwap) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(swap)
+	store.Set(keyQueuedSwap(swap.SwapID), bz)
+}
+
+// DeleteQueuedSwap removes a queued swap from the store
+func (k Keeper) DeleteQueuedSwap(ctx sdk.Context, swapID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(keyQueuedSwap(swapID))
+}
+
+// IterateQueuedSwaps iterates over the queued swaps in the order they were submitted
+func (k Keeper) IterateQueuedSwaps(ctx sdk.Context, handler func(swap QueuedSwap) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixQueuedSwap)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var swap QueuedSwap
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &swap)
+		if handler(swap) {
+			break
+		}
+	}
+}
+
+// settleQueuedSwap swaps the escrowed coins of a queued swap at the current oracle rate
+func (k Keeper) settleQueuedSwap(ctx sdk.Context, swap QueuedSwap) (swapCoin sdk.Coin, spreadFees sdk.Coins, err sdk.Error) {
+	swapCoin, spread, err := k.GetSwapCoin(ctx, swap.OfferCoin, swap.AskDenom, false)
+	if err != nil {
+		return
+	}
+
+	swapCoin, spreadFees = computeSpreadFee(swapCoin, spread)
+	err = k.settleEscrow(ctx, swap.Trader, swap.OfferCoin, swapCoin, spreadFees)
+	if err != nil {
+		return
+	}
+
+	k.DeleteQueuedSwap(ctx, swap.SwapID)
+	return
+}
+
+// refundQueuedSwap returns the escrowed coins of a queued swap that could not be settled
+func (k Keeper) refundQueuedSwap(ctx sdk.Context, swap QueuedSwap) sdk.Error {
+	err := k.releaseEscrow(ctx, swap.Trader, swap.OfferCoin)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteQueuedSwap(ctx, swap.SwapID)
+	return nil
+}
+
+//-----------------------------------
+// Limit order logic
+
+// NewOrderID generates a new limit order id; advances sequentially from 1
+func (k Keeper) NewOrderID(ctx sdk.Context) (orderID uint64) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyNextOrderID); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &orderID)
+		orderID++
+	} else {
+		orderID = 1
+	}
+
+	k.setLastOrderID(ctx, orderID)
+	return
+}
+
+// setLastOrderID stores the last issued limit order id
+func (k Keeper) setLastOrderID(ctx sdk.Context, orderID uint64) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(orderID)
+	store.Set(keyNextOrderID, bz)
+}
+
+// GetLimitOrder gets the limit order with the given id from the store
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderID uint64) (order LimitOrder, err sdk.Error) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyLimitOrder(orderID)); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &order)
+	} else {
+		err = ErrOrderNotFound(DefaultCodespace, orderID)
+	}
+	return
+}
+
+// SetLimitOrder stores a limit order and indexes it by expiry, by target rate and by owner
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order LimitOrder) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(order)
+	store.Set(keyLimitOrder(order.OrderID), bz)
+
+	orderIDBz := k.cdc.MustMarshalBinaryLengthPrefixed(order.OrderID)
+	store.Set(keyLimitOrderExpiry(order.ExpiryHeight, order.OrderID), orderIDBz)
+	store.Set(keyLimitOrderRate(order.OfferCoin.Denom, order.AskDenom, order.TargetRate, order.OrderID), orderIDBz)
+	store.Set(keyLimitOrderOwner(order.Owner, order.OrderID), orderIDBz)
+}
+
+// DeleteLimitOrder removes a limit order and its indexes from the store
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, orderID uint64) {
+	order, err := k.GetLimitOrder(ctx, orderID)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	store.Delete(keyLimitOrder(orderID))
+	store.Delete(keyLimitOrderExpiry(order.ExpiryHeight, orderID))
+	store.Delete(keyLimitOrderRate(order.OfferCoin.Denom, order.AskDenom, order.TargetRate, orderID))
+	store.Delete(keyLimitOrderOwner(order.Owner, orderID))
+}
+
+// iterateExpiredLimitOrders iterates over the ids of the limit orders expiring at or before {height}
+func (k Keeper) iterateExpiredLimitOrders(ctx sdk.Context, height int64, handler func(orderID uint64) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := store.Iterator(prefixLimitOrderExpiry, sdk.PrefixEndBytes(prefixLimitOrderExpiryHeight(height)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var orderID uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &orderID)
+		if handler(orderID) {
+			break
+		}
+	}
+}
+
+// iterateTriggeredLimitOrders iterates over the ids of the limit orders whose target rate is reached
+// by the oracle rate of their pair after the minimum spread. Orders are indexed by pair and by increasing
+// target rate, so only the triggered orders and the first untriggered order of each pair are read.
+func (k Keeper) iterateTriggeredLimitOrders(ctx sdk.Context, handler func(orderID uint64) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	minSpread := k.GetParams(ctx).MinSwapSpread
+	start := prefixLimitOrderRate
+	for {
+		iter := store.Iterator(start, sdk.PrefixEndBytes(prefixLimitOrderRate))
+		if !iter.Valid() {
+			iter.Close()
+			return
+		}
+
+		offerDenom, askDenom := parseKeyLimitOrderRatePair(iter.Key())
+		iter.Close()
+
+		pairPrefix := prefixLimitOrderRatePair(offerDenom, askDenom)
+		if rate, err := k.pairRate(ctx, offerDenom, askDenom); err == nil {
+			// Swaps involving Luna are charged at least the minimum spread
+			if offerDenom == assets.MicroLunaDenom || askDenom == assets.MicroLunaDenom {
+				rate = rate.Mul(sdk.OneDec().Sub(minSpread))
+			}
+
+			pairIter := store.Iterator(pairPrefix, sdk.PrefixEndBytes(prefixLimitOrderRateUpTo(offerDenom, askDenom, rate)))
+			for ; pairIter.Valid(); pairIter.Next() {
+				var orderID uint64
+				k.cdc.MustUnmarshalBinaryLengthPrefixed(pairIter.Value(), &orderID)
+				if handler(orderID) {
+					pairIter.Close()
+					return
+				}
+			}
+			pairIter.Close()
+		}
+
+		// Skip to the next pair
+		start = sdk.PrefixEndBytes(pairPrefix)
+	}
+}
+
+// pairRate returns the oracle rate of {askDenom} per unit of {offerDenom}, before spread
+func (k Keeper) pairRate(ctx sdk.Context, offerDenom, askDenom string) (rate sdk.Dec, err sdk.Error) {
+	offerRate, err := k.ok.GetLunaSwapRate(ctx, offerDenom)
+	if err != nil {
+		return
+	}
+
+	askRate, err := k.ok.GetLunaSwapRate(ctx, askDenom)
+	if err != nil {
+		return
+	}
+
+	return askRate.Quo(offerRate), nil
+}
+
+// IterateLimitOrders iterates over the limit orders in the order they were placed
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, handler func(order LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixLimitOrder)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order LimitOrder
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &order)
+		if handler(order) {
+			break
+		}
+	}
+}
+
+// GetLimitOrdersByOwner returns the open limit orders placed by the given trader, in the order they were placed
+func (k Keeper) GetLimitOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (orders LimitOrders) {
+	orders = LimitOrders{}
+
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixLimitOrderOwnerAddress(owner))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var orderID uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &orderID)
+
+		order, err := k.GetLimitOrder(ctx, orderID)
+		if err != nil {
+			continue
+		}
+
+		orders = append(orders, order)
+	}
+
+	return
+}
+
+// fillLimitOrder swaps the escrowed coins of the order at the current oracle rate if the rate
+// reaches the target of the order. Returns the coin credited to the owner and the spread fees
+// charged, or false if the order is not fillable yet.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order LimitOrder) (swapCoin sdk.Coin, spreadFees sdk.Coins, filled bool, err sdk.Error) {
+	swapCoin, spread, err := k.GetSwapCoin(ctx, order.OfferCoin, order.AskDenom, false)
+	if err != nil {
+		return
+	}
+
+	swapCoin, spreadFees = computeSpreadFee(swapCoin, spread)
+	if !order.isFillable(swapCoin) {
+		return
+	}
+
+	err = k.settleEscrow(ctx, order.Owner, order.OfferCoin, swapCoin, spreadFees)
+	if err != nil {
+		return
+	}
+
+	k.DeleteLimitOrder(ctx, order.OrderID)
+
+	filled = true
+	return
+}
+
+// refundLimitOrder returns the escrowed coins of the order to its owner and removes the order
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order LimitOrder) sdk.Error {
+	err := k.releaseEscrow(ctx, order.Owner, order.OfferCoin)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteLimitOrder(ctx, order.OrderID)
+	return nil
+}
+
+//-----------------------------------
+// Spread fee logic
+
+// collectSpreadFees splits the spread fees withheld from a swap between the oracle swap fee pool,
+// the distribution community pool and the burn, and records the split for the current epoch.
+// Burned fees need no further action as they were never minted to the trader.
+func (k Keeper) collectSpreadFees(ctx sdk.Context, fees sdk.Coins) sdk.Error {
+	if fees.Empty() {
+		return nil
+	}
+
+	oracleRewards, communityPool, burned := splitSpreadFees(fees, k.GetParams(ctx))
+
+	if !oracleRewards.Empty() {
+		k.ok.AddSwapFeePool(ctx, oracleRewards)
+
+		// Coins in the swap fee pool are part of the issuance
+		for _, coin := range oracleRewards {
+			err := k.mk.ChangeIssuance(ctx, coin.Denom, coin.Amount)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !communityPool.Empty() {
+		feePool := k.dk.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(communityPool))
+		k.dk.SetFeePool(ctx, feePool)
+
+		// Coins in the community pool are part of the issuance, and its luna is tracked by the staking pool
+		for _, coin := range communityPool {
+			err := k.mk.ChangeIssuance(ctx, coin.Denom, coin.Amount)
+			if err != nil {
+				return err
+			}
+		}
+		k.mk.ChangeNotBondedTokens(ctx, communityPool.AmountOf(assets.MicroLunaDenom))
+	}
+
+	epoch := k.ck.GetEpoch(ctx)
+	distribution := k.GetSpreadFeeDistribution(ctx, epoch)
+	distribution.OracleRewards = distribution.OracleRewards.Add(oracleRewards)
+	distribution.CommunityPool = distribution.CommunityPool.Add(communityPool)
+	distribution.Burned = distribution.Burned.Add(burned)
+	k.SetSpreadFeeDistribution(ctx, distribution)
+
+	return nil
+}
+
+// GetSpreadFeeDistribution returns the destinations of the spread fees collected in the epoch
+func (k Keeper) GetSpreadFeeDistribution(ctx sdk.Context, epoch sdk.Int) (distribution SpreadFeeDistribution) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keySpreadFeeDistribution(epoch))
+	if bz == nil {
+		return NewSpreadFeeDistribution(epoch, sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &distribution)
+	return
+}
+
+// SetSpreadFeeDistribution stores the destinations of the spread fees collected in an epoch
+func (k Keeper) SetSpreadFeeDistribution(ctx sdk.Context, distribution SpreadFeeDistribution) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(distribution)
+	store.Set(keySpreadFeeDistribution(distribution.Epoch), bz)
+}
+
+// IterateSpreadFeeDistributions iterates over the spread fee distributions of all epochs
+func (k Keeper) IterateSpreadFeeDistributions(ctx sdk.Context, handler func(distribution SpreadFeeDistribution) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixSpreadFeeDistribution)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var distribution SpreadFeeDistribution
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &distribution)
+		if handler(distribution) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // Params logic
 
@@ -1,13 +1,88 @@
 package market
 
 import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 var (
 	paramStoreKeyParams = []byte("params")
+
+	prefixSwapVolume = []byte("swap_volume")
+	prefixSwapStats  = []byte("swap_stats")
+
+	prefixSpreadFeeDistribution = []byte("spread_fee_distribution")
+
+	prefixLimitOrder       = []byte("limit_order")
+	prefixLimitOrderExpiry = []byte("order_expiry")
+	prefixLimitOrderRate   = []byte("order_rate")
+	prefixLimitOrderOwner  = []byte("order_owner")
+	prefixQueuedSwap       = []byte("queued_swap")
+	keyNextOrderID         = []byte("next_order_id")
 )
 
+func keySwapVolume(unit string, period sdk.Int, offerDenom, askDenom string) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s:%s:%s", prefixSwapVolume, unit, period, offerDenom, askDenom))
+}
+
+func prefixSwapVolumeForPeriod(unit string, period sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s:", prefixSwapVolume, unit, period))
+}
+
+func keySwapStats(unit string, period sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s", prefixSwapStats, unit, period))
+}
+
+func keySpreadFeeDistribution(epoch sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s", prefixSpreadFeeDistribution, epoch))
+}
+
+func keyLimitOrder(orderID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%020d", prefixLimitOrder, orderID))
+}
+
+func keyLimitOrderExpiry(expiryHeight int64, orderID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%020d:%020d", prefixLimitOrderExpiry, expiryHeight, orderID))
+}
+
+func prefixLimitOrderExpiryHeight(expiryHeight int64) []byte {
+	return []byte(fmt.Sprintf("%s:%020d:", prefixLimitOrderExpiry, expiryHeight))
+}
+
+// Target rates are written as zero-padded integers in units of 10^-18, so that the keys of a pair
+// sort by increasing target rate
+func keyLimitOrderRate(offerDenom, askDenom string, targetRate sdk.Dec, orderID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s:%040d:%020d", prefixLimitOrderRate, offerDenom, askDenom, targetRate.Int, orderID))
+}
+
+func prefixLimitOrderRatePair(offerDenom, askDenom string) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s:", prefixLimitOrderRate, offerDenom, askDenom))
+}
+
+func prefixLimitOrderRateUpTo(offerDenom, askDenom string, rate sdk.Dec) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s:%040d:", prefixLimitOrderRate, offerDenom, askDenom, rate.Int))
+}
+
+func parseKeyLimitOrderRatePair(key []byte) (offerDenom, askDenom string) {
+	parts := strings.Split(string(key), ":")
+	return parts[1], parts[2]
+}
+
+func keyLimitOrderOwner(owner sdk.AccAddress, orderID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%020d", prefixLimitOrderOwner, owner, orderID))
+}
+
+func prefixLimitOrderOwnerAddress(owner sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s:%s:", prefixLimitOrderOwner, owner))
+}
+
+func keyQueuedSwap(swapID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%020d", prefixQueuedSwap, swapID))
+}
+
 func paramKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		paramStoreKeyParams, Params{},
@@ -88,3 +88,95 @@ func TestKeeperSwapDecCoins(t *testing.T) {
 
 	require.Equal(t, retCoin, askCoin)
 }
+
+func TestKeeperRecordSwap(t *testing.T) {
+	input := createTestInput(t)
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch + util.BlocksPerDay)
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	swapCoin := sdk.NewInt64Coin(assets.MicroLunaDenom, 98)
+	spreadFees := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 2))
+
+	input.marketKeeper.RecordSwap(input.ctx, offerCoin, swapCoin, spreadFees)
+	input.marketKeeper.RecordSwap(input.ctx, offerCoin, swapCoin, spreadFees)
+	input.marketKeeper.RecordSwap(input.ctx, swapCoin, offerCoin, sdk.Coins{})
+
+	day := input.calendarKeeper.GetDay(input.ctx)
+	epoch := input.calendarKeeper.GetEpoch(input.ctx)
+
+	for _, period := range []struct {
+		unit  string
+		index sdk.Int
+	}{{PeriodDay, day}, {PeriodEpoch, epoch}} {
+		volume := input.marketKeeper.GetSwapVolume(input.ctx, period.unit, period.index, assets.MicroSDRDenom, assets.MicroLunaDenom)
+		require.Equal(t, sdk.NewInt(2000), volume.OfferAmount)
+		require.Equal(t, sdk.NewInt(196), volume.AskAmount)
+
+		volumes := input.marketKeeper.GetSwapVolumes(input.ctx, period.unit, period.index)
+		require.Equal(t, 2, len(volumes))
+
+		stats := input.marketKeeper.GetSwapStats(input.ctx, period.unit, period.index)
+		require.Equal(t, int64(3), stats.SwapCount)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 4)), stats.SpreadFees)
+	}
+
+	// Nothing recorded for other periods
+	require.Equal(t, 0, len(input.marketKeeper.GetSwapVolumes(input.ctx, PeriodDay, day.Sub(sdk.OneInt()))))
+	require.Equal(t, int64(0), input.marketKeeper.GetSwapStats(input.ctx, PeriodEpoch, epoch.Add(sdk.OneInt())).SwapCount)
+}
+
+func TestKeeperCollectSpreadFees(t *testing.T) {
+	input := createTestInput(t)
+
+	params := input.marketKeeper.GetParams(input.ctx)
+	params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+	params.CommunityPoolFeeShare = sdk.NewDecWithPrec(3, 1)
+	params.BurnFeeShare = sdk.NewDecWithPrec(2, 1)
+	input.marketKeeper.SetParams(input.ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 1001))
+	err := input.marketKeeper.collectSpreadFees(input.ctx, fees)
+	require.Nil(t, err)
+
+	oracleRewards := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 500))
+	communityPool := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 300))
+	burned := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 201))
+
+	require.Equal(t, oracleRewards, input.oracleKeeper.GetSwapFeePool(input.ctx))
+	require.Equal(t, sdk.NewDecCoins(communityPool), input.marketKeeper.dk.GetFeePool(input.ctx).CommunityPool)
+
+	distribution := input.marketKeeper.GetSpreadFeeDistribution(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
+	require.Equal(t, oracleRewards, distribution.OracleRewards)
+	require.Equal(t, communityPool, distribution.CommunityPool)
+	require.Equal(t, burned, distribution.Burned)
+}
+
+func TestKeeperLimitOrderIndexes(t *testing.T) {
+	input := createTestInput(t)
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.OneDec())
+
+	// The luna pair is charged at least the 2% minimum spread; the sdr rate is 1 per luna
+	offerCoin := sdk.NewInt64Coin(assets.MicroLunaDenom, 1000)
+	input.marketKeeper.SetLimitOrder(input.ctx, NewLimitOrder(1, addrs[0], offerCoin, assets.MicroSDRDenom, sdk.NewDecWithPrec(99, 2), 100))
+	input.marketKeeper.SetLimitOrder(input.ctx, NewLimitOrder(2, addrs[1], offerCoin, assets.MicroSDRDenom, sdk.NewDecWithPrec(97, 2), 100))
+	input.marketKeeper.SetLimitOrder(input.ctx, NewLimitOrder(3, addrs[0], offerCoin, assets.MicroSDRDenom, sdk.NewDecWithPrec(96, 2), 100))
+
+	// Targets reached before spread but not after the minimum spread are not triggered
+	var triggered []uint64
+	input.marketKeeper.iterateTriggeredLimitOrders(input.ctx, func(orderID uint64) (stop bool) {
+		triggered = append(triggered, orderID)
+		return false
+	})
+	require.Equal(t, []uint64{3, 2}, triggered)
+
+	// Orders are indexed by owner
+	orders := input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(1), orders[0].OrderID)
+	require.Equal(t, uint64(3), orders[1].OrderID)
+
+	input.marketKeeper.DeleteLimitOrder(input.ctx, 1)
+	orders = input.marketKeeper.GetLimitOrdersByOwner(input.ctx, addrs[0])
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(3), orders[0].OrderID)
+}
@@ -9,6 +9,8 @@ const (
 	LogKeySwapCoin = string("swap_coin")
 	// LogKeySwapFee is the fee for swap operation
 	LogKeySwapFee = string("swap_fee")
+	// LogKeyQueuedSwapID is the id of a swap queued for settlement at the end of the block
+	LogKeyQueuedSwapID = string("queued_swap_id")
 )
 
 // Log is map type object to organize msg result
@@ -66,3 +66,129 @@ func (msg MsgSwap) String() string {
 	ask:       %s`,
 		msg.Trader, msg.OfferCoin, msg.AskDenom)
 }
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgPlaceLimitSwap contains a limit swap order; the offer coin is escrowed until the order
+// is filled at or above the target rate, cancelled, or expires.
+type MsgPlaceLimitSwap struct {
+	Trader       sdk.AccAddress `json:"trader"`        // Address of the trader
+	OfferCoin    sdk.Coin       `json:"offer_coin"`    // Coin being offered
+	AskDenom     string         `json:"ask_denom"`     // Denom of the coin to swap to
+	TargetRate   sdk.Dec        `json:"target_rate"`   // Minimum amount of ask coins per unit of offer coin
+	ExpiryHeight int64          `json:"expiry_height"` // Block height at which the order expires
+}
+
+// NewMsgPlaceLimitSwap creates a MsgPlaceLimitSwap instance
+func NewMsgPlaceLimitSwap(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askDenom string, targetRate sdk.Dec, expiryHeight int64) MsgPlaceLimitSwap {
+	return MsgPlaceLimitSwap{
+		Trader:       traderAddress,
+		OfferCoin:    offerCoin,
+		AskDenom:     askDenom,
+		TargetRate:   targetRate,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgPlaceLimitSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgPlaceLimitSwap) Type() string { return "placelimitswap" }
+
+// GetSignBytes Implements Msg
+func (msg MsgPlaceLimitSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgPlaceLimitSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgPlaceLimitSwap) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	if len(msg.OfferCoin.Denom) == 0 || !msg.OfferCoin.IsPositive() {
+		return ErrInsufficientSwapCoins(DefaultCodespace, msg.OfferCoin.Amount)
+	}
+
+	if msg.OfferCoin.Denom == msg.AskDenom {
+		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom)
+	}
+
+	if msg.TargetRate.IsNil() || !msg.TargetRate.IsPositive() {
+		return ErrInvalidTargetRate(DefaultCodespace, msg.TargetRate)
+	}
+
+	if msg.ExpiryHeight <= 0 {
+		return ErrInvalidExpiry(DefaultCodespace, msg.ExpiryHeight)
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgPlaceLimitSwap) String() string {
+	return fmt.Sprintf(`MsgPlaceLimitSwap
+	trader:    %s, 
+	offer:     %s, 
+	ask:       %s, 
+	rate:      %s, 
+	expiry:    %d`,
+		msg.Trader, msg.OfferCoin, msg.AskDenom, msg.TargetRate, msg.ExpiryHeight)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgCancelLimitSwap cancels an open limit swap order and refunds the escrowed coin
+type MsgCancelLimitSwap struct {
+	Trader  sdk.AccAddress `json:"trader"`   // Address of the trader
+	OrderID uint64         `json:"order_id"` // ID of the order to cancel
+}
+
+// NewMsgCancelLimitSwap creates a MsgCancelLimitSwap instance
+func NewMsgCancelLimitSwap(traderAddress sdk.AccAddress, orderID uint64) MsgCancelLimitSwap {
+	return MsgCancelLimitSwap{
+		Trader:  traderAddress,
+		OrderID: orderID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelLimitSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCancelLimitSwap) Type() string { return "cancellimitswap" }
+
+// GetSignBytes Implements Msg
+func (msg MsgCancelLimitSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(msgCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgCancelLimitSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgCancelLimitSwap) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgCancelLimitSwap) String() string {
+	return fmt.Sprintf(`MsgCancelLimitSwap
+	trader:    %s, 
+	order:     %d`,
+		msg.Trader, msg.OrderID)
+}
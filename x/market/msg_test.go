@@ -30,3 +30,29 @@ func TestMsgPriceFeed(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgPlaceLimitSwap(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		offerCoin    sdk.Coin
+		askDenom     string
+		targetRate   sdk.Dec
+		expiryHeight int64
+		expectPass   bool
+	}{
+		{sdk.NewInt64Coin(assets.MicroLunaDenom, 1000), assets.MicroKRWDenom, sdk.NewDec(300), 100, true},
+		{sdk.NewInt64Coin(assets.MicroLunaDenom, 1000), assets.MicroLunaDenom, sdk.NewDec(300), 100, false},
+		{sdk.NewInt64Coin(assets.MicroLunaDenom, 0), assets.MicroKRWDenom, sdk.NewDec(300), 100, false},
+		{sdk.NewInt64Coin(assets.MicroLunaDenom, 1000), assets.MicroKRWDenom, sdk.ZeroDec(), 100, false},
+		{sdk.NewInt64Coin(assets.MicroLunaDenom, 1000), assets.MicroKRWDenom, sdk.NewDec(300), 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgPlaceLimitSwap(addrs[0], tc.offerCoin, tc.askDenom, tc.targetRate, tc.expiryHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package market
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LimitOrder - struct to store a limit swap waiting for the oracle rate to reach its target
+type LimitOrder struct {
+	OrderID      uint64         `json:"order_id"`      // ID of the order
+	Owner        sdk.AccAddress `json:"owner"`         // Address of the trader who placed the order
+	OfferCoin    sdk.Coin       `json:"offer_coin"`    // Coin held in escrow for the swap
+	AskDenom     string         `json:"ask_denom"`     // Denom of the coin to swap to
+	TargetRate   sdk.Dec        `json:"target_rate"`   // Minimum amount of ask coins to receive per unit of offer coin, net of spread
+	ExpiryHeight int64          `json:"expiry_height"` // Block height at which the order is cancelled and refunded
+}
+
+// NewLimitOrder creates a LimitOrder instance
+func NewLimitOrder(orderID uint64, owner sdk.AccAddress, offerCoin sdk.Coin, askDenom string, targetRate sdk.Dec, expiryHeight int64) LimitOrder {
+	return LimitOrder{
+		OrderID:      orderID,
+		Owner:        owner,
+		OfferCoin:    offerCoin,
+		AskDenom:     askDenom,
+		TargetRate:   targetRate,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// isFillable returns true if the swapped coin reaches the target rate of the order
+func (o LimitOrder) isFillable(swapCoin sdk.Coin) bool {
+	return sdk.NewDecFromInt(swapCoin.Amount).GTE(o.TargetRate.MulInt(o.OfferCoin.Amount))
+}
+
+// String implements fmt.Stringer
+func (o LimitOrder) String() string {
+	return fmt.Sprintf(`LimitOrder
+	OrderID:      %d
+	Owner:        %s
+	OfferCoin:    %s
+	AskDenom:     %s
+	TargetRate:   %s
+	ExpiryHeight: %d`,
+		o.OrderID, o.Owner, o.OfferCoin, o.AskDenom, o.TargetRate, o.ExpiryHeight)
+}
+
+// LimitOrders is a collection of LimitOrder
+type LimitOrders []LimitOrder
+
+func (l LimitOrders) String() (out string) {
+	for _, val := range l {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// QueuedSwap - struct to store a swap waiting to be settled at the end of the block
+type QueuedSwap struct {
+	SwapID      uint64         `json:"swap_id"`      // ID of the queued swap
+	Trader      sdk.AccAddress `json:"trader"`       // Address of the trader
+	OfferCoin   sdk.Coin       `json:"offer_coin"`   // Coin held in escrow for the swap
+	AskDenom    string         `json:"ask_denom"`    // Denom of the coin to swap to
+	SubmitBlock int64          `json:"submit_block"` // Block height at which the swap was submitted
+}
+
+// NewQueuedSwap creates a QueuedSwap instance
+func NewQueuedSwap(swapID uint64, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string, submitBlock int64) QueuedSwap {
+	return QueuedSwap{
+		SwapID:      swapID,
+		Trader:      trader,
+		OfferCoin:   offerCoin,
+		AskDenom:    askDenom,
+		SubmitBlock: submitBlock,
+	}
+}
+
+// String implements fmt.Stringer
+func (s QueuedSwap) String() string {
+	return fmt.Sprintf(`QueuedSwap
+	SwapID:      %d
+	Trader:      %s
+	OfferCoin:   %s
+	AskDenom:    %s
+	SubmitBlock: %d`,
+		s.SwapID, s.Trader, s.OfferCoin, s.AskDenom, s.SubmitBlock)
+}
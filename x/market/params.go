@@ -11,14 +11,25 @@ type Params struct {
 	DailyLunaDeltaCap sdk.Dec `json:"daily_luna_delta_limit"` // daily % inflation or deflation cap on Luna
 	MinSwapSpread     sdk.Dec `json:"min_swap_spread"`        // minimum spread for swaps involving Luna
 	MaxSwapSpread     sdk.Dec `json:"max_swap_spread"`        // maximum spread for swaps involving Luna
+
+	OracleFeeShare        sdk.Dec `json:"oracle_fee_share"`         // fraction of spread fees rewarded to oracle ballot winners
+	CommunityPoolFeeShare sdk.Dec `json:"community_pool_fee_share"` // fraction of spread fees sent to the distribution community pool
+	BurnFeeShare          sdk.Dec `json:"burn_fee_share"`           // fraction of spread fees burned outright
+
+	DeferredSwaps bool `json:"deferred_swaps"` // queue swaps and settle them at the end of the block, after the oracle
 }
 
 // NewParams creates a new param instance
-func NewParams(dailyLunaDeltaCap, minSwapSpread, maxSwapSpread sdk.Dec) Params {
+func NewParams(dailyLunaDeltaCap, minSwapSpread, maxSwapSpread,
+	oracleFeeShare, communityPoolFeeShare, burnFeeShare sdk.Dec, deferredSwaps bool) Params {
 	return Params{
-		DailyLunaDeltaCap: dailyLunaDeltaCap,
-		MinSwapSpread:     minSwapSpread,
-		MaxSwapSpread:     maxSwapSpread,
+		DailyLunaDeltaCap:     dailyLunaDeltaCap,
+		MinSwapSpread:         minSwapSpread,
+		MaxSwapSpread:         maxSwapSpread,
+		OracleFeeShare:        oracleFeeShare,
+		CommunityPoolFeeShare: communityPoolFeeShare,
+		BurnFeeShare:          burnFeeShare,
+		DeferredSwaps:         deferredSwaps,
 	}
 }
 
@@ -28,6 +39,10 @@ func DefaultParams() Params {
 		sdk.NewDecWithPrec(5, 3),  // 0.5%
 		sdk.NewDecWithPrec(2, 2),  // 2%
 		sdk.NewDecWithPrec(10, 1), // 10%
+		sdk.OneDec(),              // 100%
+		sdk.ZeroDec(),             // 0%
+		sdk.ZeroDec(),             // 0%
+		false,
 	)
 }
 
@@ -41,6 +56,13 @@ func validateParams(params Params) error {
 	if params.MaxSwapSpread.LT(params.MinSwapSpread) {
 		return fmt.Errorf("market maximum swap spead should be larger or equal to the minimum, is %s", params.MaxSwapSpread.String())
 	}
+	if params.OracleFeeShare.IsNegative() || params.CommunityPoolFeeShare.IsNegative() || params.BurnFeeShare.IsNegative() {
+		return fmt.Errorf("market spread fee shares should be non-negative, are %s, %s, %s",
+			params.OracleFeeShare, params.CommunityPoolFeeShare, params.BurnFeeShare)
+	}
+	if shareSum := params.OracleFeeShare.Add(params.CommunityPoolFeeShare).Add(params.BurnFeeShare); !shareSum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("market spread fee shares should sum up to 1, is %s", shareSum)
+	}
 
 	return nil
 }
@@ -49,6 +71,11 @@ func (params Params) String() string {
 	return fmt.Sprintf(`market Params:
 	DailyLunaDeltaCap: %v,
 	MinSwapSpread:  %v,
-	MaxSwapSpread:  %v
-  `, params.DailyLunaDeltaCap, params.MinSwapSpread, params.MaxSwapSpread)
+	MaxSwapSpread:  %v,
+	OracleFeeShare:  %v,
+	CommunityPoolFeeShare:  %v,
+	BurnFeeShare:  %v,
+	DeferredSwaps:  %v
+  `, params.DailyLunaDeltaCap, params.MinSwapSpread, params.MaxSwapSpread,
+		params.OracleFeeShare, params.CommunityPoolFeeShare, params.BurnFeeShare, params.DeferredSwaps)
 }
@@ -1,6 +1,9 @@
 package market
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,8 +12,12 @@ import (
 
 // query endpoints supported by the oracle Querier
 const (
-	QuerySwap   = "swap"
-	QueryParams = "params"
+	QuerySwap       = "swap"
+	QueryVolume     = "volume"
+	QueryStats      = "stats"
+	QueryOrders     = "orders"
+	QuerySpreadFees = "spread-fees"
+	QueryParams     = "params"
 )
 
 // NewQuerier is the module level router for state queries
@@ -19,6 +26,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case QuerySwap:
 			return querySwap(ctx, path[1:], req, keeper)
+		case QueryVolume:
+			return queryVolume(ctx, path[1:], req, keeper)
+		case QueryStats:
+			return queryStats(ctx, path[1:], req, keeper)
+		case QuerySpreadFees:
+			return querySpreadFees(ctx, path[1:], req, keeper)
+		case QueryOrders:
+			return queryOrders(ctx, req, keeper)
 		case QueryParams:
 			return queryParams(ctx, req, keeper)
 		default:
@@ -56,13 +71,7 @@ func querySwap(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Failed to get swapped coin amount", err.Error()))
 	}
 
-	if spread.IsPositive() {
-		swapFeeAmt := spread.MulInt(swapCoin.Amount).TruncateInt()
-		if swapFeeAmt.IsPositive() {
-			swapFee := sdk.NewCoin(swapCoin.Denom, swapFeeAmt)
-			swapCoin = swapCoin.Sub(swapFee)
-		}
-	}
+	swapCoin, _ = computeSpreadFee(swapCoin, spread)
 
 	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, swapCoin)
 	if err2 != nil {
@@ -72,6 +81,124 @@ func querySwap(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	return bz, nil
 }
 
+// parsePeriod reads the aggregation unit and the optional period index from the query path;
+// the period defaults to the current day or epoch.
+func parsePeriod(ctx sdk.Context, keeper Keeper, path []string) (unit string, period sdk.Int, err sdk.Error) {
+	if len(path) == 0 || !isValidPeriodUnit(path[0]) {
+		return "", sdk.Int{}, sdk.ErrUnknownRequest(fmt.Sprintf("period unit should be either %s or %s", PeriodDay, PeriodEpoch))
+	}
+
+	unit = path[0]
+	if len(path) < 2 || len(path[1]) == 0 {
+		return unit, keeper.currentPeriod(ctx, unit), nil
+	}
+
+	period, ok := sdk.NewIntFromString(path[1])
+	if !ok || period.IsNegative() {
+		return "", sdk.Int{}, sdk.ErrUnknownRequest(fmt.Sprintf("%s parameter is not correctly formatted", unit))
+	}
+
+	return unit, period, nil
+}
+
+// JSON response format
+type QueryVolumeResponse struct {
+	Unit    string      `json:"unit"`
+	Period  sdk.Int     `json:"period"`
+	Volumes SwapVolumes `json:"volumes"`
+}
+
+func (r QueryVolumeResponse) String() (out string) {
+	out = fmt.Sprintf("%s %s\n", r.Unit, r.Period) + r.Volumes.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryVolume(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	unit, period, err := parsePeriod(ctx, keeper, path)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := keeper.GetSwapVolumes(ctx, unit, period)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, QueryVolumeResponse{Unit: unit, Period: period, Volumes: volumes})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryStats(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	unit, period, err := parsePeriod(ctx, keeper, path)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := keeper.GetSwapStats(ctx, unit, period)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, stats)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func querySpreadFees(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	epoch := keeper.currentPeriod(ctx, PeriodEpoch)
+	if len(path) > 0 && len(path[0]) != 0 {
+		var ok bool
+		epoch, ok = sdk.NewIntFromString(path[0])
+		if !ok || epoch.IsNegative() {
+			return nil, sdk.ErrUnknownRequest("epoch parameter is not correctly formatted")
+		}
+	}
+
+	distribution := keeper.GetSpreadFeeDistribution(ctx, epoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, distribution)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// QueryOrdersParams for query 'custom/market/orders'
+type QueryOrdersParams struct {
+	Owner sdk.AccAddress
+}
+
+func NewQueryOrdersParams(owner sdk.AccAddress) QueryOrdersParams {
+	return QueryOrdersParams{
+		Owner: owner,
+	}
+}
+
+// JSON response format
+type QueryOrdersResponse struct {
+	Orders LimitOrders `json:"orders"`
+}
+
+func (r QueryOrdersResponse) String() (out string) {
+	out = r.Orders.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryOrders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryOrdersParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	orders := keeper.GetLimitOrdersByOwner(ctx, params.Owner)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryOrdersResponse{Orders: orders})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
 	if err != nil {
@@ -0,0 +1,78 @@
+package market
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const custom = "custom"
+
+func TestQueryVolume(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.marketKeeper)
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch)
+
+	offerCoin := sdk.NewInt64Coin(assets.MicroSDRDenom, 1000)
+	swapCoin := sdk.NewInt64Coin(assets.MicroKRWDenom, 1000000)
+	input.marketKeeper.RecordSwap(input.ctx, offerCoin, swapCoin, sdk.Coins{})
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryVolume}, "/"),
+		Data: []byte{},
+	}
+
+	// Current epoch is used when the period is omitted
+	bz, err := querier(input.ctx, []string{QueryVolume, PeriodEpoch}, query)
+	require.Nil(t, err)
+
+	var response QueryVolumeResponse
+	input.marketKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, PeriodEpoch, response.Unit)
+	require.Equal(t, input.calendarKeeper.GetEpoch(input.ctx), response.Period)
+	require.Equal(t, 1, len(response.Volumes))
+	require.Equal(t, offerCoin.Amount, response.Volumes[0].OfferAmount)
+	require.Equal(t, swapCoin.Amount, response.Volumes[0].AskAmount)
+
+	// Past day has no volume
+	bz, err = querier(input.ctx, []string{QueryVolume, PeriodDay, "0"}, query)
+	require.Nil(t, err)
+
+	input.marketKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, 0, len(response.Volumes))
+
+	// Invalid unit and period
+	_, err = querier(input.ctx, []string{QueryVolume, "week"}, query)
+	require.NotNil(t, err)
+
+	_, err = querier(input.ctx, []string{QueryVolume, PeriodDay, "abc"}, query)
+	require.NotNil(t, err)
+}
+
+func TestQueryStats(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.marketKeeper)
+
+	spreadFees := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 10))
+	input.marketKeeper.RecordSwap(input.ctx, sdk.NewInt64Coin(assets.MicroSDRDenom, 1000), sdk.NewInt64Coin(assets.MicroLunaDenom, 990), spreadFees)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryStats}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(input.ctx, []string{QueryStats, PeriodDay, "0"}, query)
+	require.Nil(t, err)
+
+	var stats SwapStats
+	input.marketKeeper.cdc.MustUnmarshalJSON(bz, &stats)
+	require.Equal(t, int64(1), stats.SwapCount)
+	require.Equal(t, spreadFees, stats.SpreadFees)
+}
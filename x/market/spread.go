@@ -1 +1,82 @@
 package market
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// computeSpreadFee splits the swapped coin into the amount credited to the trader and the spread fee
+// withheld from it. No fee is charged when the spread is not positive or rounds down to zero.
+func computeSpreadFee(swapCoin sdk.Coin, spread sdk.Dec) (sdk.Coin, sdk.Coins) {
+	if !spread.IsPositive() {
+		return swapCoin, sdk.Coins{}
+	}
+
+	swapFeeAmt := spread.MulInt(swapCoin.Amount).TruncateInt()
+	if !swapFeeAmt.IsPositive() {
+		return swapCoin, sdk.Coins{}
+	}
+
+	swapFee := sdk.NewCoin(swapCoin.Denom, swapFeeAmt)
+	return swapCoin.Sub(swapFee), sdk.NewCoins(swapFee)
+}
+
+// SpreadFeeDistribution - struct to record where the spread fees collected in an epoch were sent
+type SpreadFeeDistribution struct {
+	Epoch         sdk.Int   `json:"epoch"`          // Epoch in which the fees were collected
+	OracleRewards sdk.Coins `json:"oracle_rewards"` // Fees added to the oracle swap fee pool
+	CommunityPool sdk.Coins `json:"community_pool"` // Fees added to the distribution community pool
+	Burned        sdk.Coins `json:"burned"`         // Fees burned outright
+}
+
+// NewSpreadFeeDistribution creates a SpreadFeeDistribution instance
+func NewSpreadFeeDistribution(epoch sdk.Int, oracleRewards, communityPool, burned sdk.Coins) SpreadFeeDistribution {
+	return SpreadFeeDistribution{
+		Epoch:         epoch,
+		OracleRewards: oracleRewards,
+		CommunityPool: communityPool,
+		Burned:        burned,
+	}
+}
+
+// String implements fmt.Stringer
+func (d SpreadFeeDistribution) String() string {
+	return fmt.Sprintf(`SpreadFeeDistribution
+	Epoch:         %s
+	OracleRewards: %s
+	CommunityPool: %s
+	Burned:        %s`,
+		d.Epoch, d.OracleRewards, d.CommunityPool, d.Burned)
+}
+
+// SpreadFeeDistributions is a collection of SpreadFeeDistribution
+type SpreadFeeDistributions []SpreadFeeDistribution
+
+func (l SpreadFeeDistributions) String() (out string) {
+	for _, val := range l {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// splitSpreadFees divides the spread fees between the oracle, the community pool and the burn
+// according to the shares in params. Rounding dust is burned.
+func splitSpreadFees(fees sdk.Coins, params Params) (oracleRewards, communityPool, burned sdk.Coins) {
+	oracleRewards = sdk.Coins{}
+	communityPool = sdk.Coins{}
+	burned = sdk.Coins{}
+
+	for _, fee := range fees {
+		oracleAmt := params.OracleFeeShare.MulInt(fee.Amount).TruncateInt()
+		communityAmt := params.CommunityPoolFeeShare.MulInt(fee.Amount).TruncateInt()
+		burnAmt := fee.Amount.Sub(oracleAmt).Sub(communityAmt)
+
+		oracleRewards = oracleRewards.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, oracleAmt)))
+		communityPool = communityPool.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, communityAmt)))
+		burned = burned.Add(sdk.NewCoins(sdk.NewCoin(fee.Denom, burnAmt)))
+	}
+
+	return
+}
@@ -1,7 +1,18 @@
 package tags
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Market tags
 var (
-	Offer  = "offer"
-	Trader = "trader"
+	ActionLimitOrderFilled  = "limit-order-filled"
+	ActionLimitOrderExpired = "limit-order-expired"
+	ActionSwapSettled       = "swap-settled"
+	ActionSwapRefunded      = "swap-refunded"
+
+	Action  = sdk.TagAction
+	Offer   = "offer"
+	Trader  = "trader"
+	OrderID = "order_id"
 )
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
 
@@ -35,12 +36,13 @@ var (
 )
 
 type testInput struct {
-	ctx          sdk.Context
-	accKeeper    auth.AccountKeeper
-	bankKeeper   bank.Keeper
-	marketKeeper Keeper
-	oracleKeeper oracle.Keeper
-	mintKeeper   mint.Keeper
+	ctx            sdk.Context
+	accKeeper      auth.AccountKeeper
+	bankKeeper     bank.Keeper
+	marketKeeper   Keeper
+	oracleKeeper   oracle.Keeper
+	mintKeeper     mint.Keeper
+	calendarKeeper calendar.Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -61,6 +63,7 @@ func createTestInput(t *testing.T) testInput {
 	keyMarket := sdk.NewKVStoreKey(StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracle.StoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
@@ -78,6 +81,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
@@ -119,6 +123,10 @@ func createTestInput(t *testing.T) testInput {
 
 	stakingKeeper.SetPool(ctx, staking.InitialPool())
 	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+	distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
+
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
 
 	mintKeeper := mint.NewKeeper(
 		cdc,
@@ -126,6 +134,8 @@ func createTestInput(t *testing.T) testInput {
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	oracleKeeper := oracle.NewKeeper(
@@ -143,6 +153,9 @@ func createTestInput(t *testing.T) testInput {
 		keyMarket,
 		oracleKeeper,
 		mintKeeper,
+		bankKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(DefaultParamspace),
 	)
 
@@ -153,5 +166,5 @@ func createTestInput(t *testing.T) testInput {
 		require.NoError(t, err)
 	}
 
-	return testInput{ctx, accKeeper, bankKeeper, marketKeeper, oracleKeeper, mintKeeper}
+	return testInput{ctx, accKeeper, bankKeeper, marketKeeper, oracleKeeper, mintKeeper, calendarKeeper}
 }
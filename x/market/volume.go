@@ -0,0 +1,110 @@
+package market
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Period units over which swap volume and statistics are aggregated
+const (
+	PeriodDay   = "day"
+	PeriodEpoch = "epoch"
+)
+
+// isValidPeriodUnit returns true if the given unit is a known aggregation period
+func isValidPeriodUnit(unit string) bool {
+	return unit == PeriodDay || unit == PeriodEpoch
+}
+
+// currentPeriod returns the index of the day or epoch the context belongs to
+func (k Keeper) currentPeriod(ctx sdk.Context, unit string) sdk.Int {
+	if unit == PeriodEpoch {
+		return k.ck.GetEpoch(ctx)
+	}
+
+	return k.ck.GetDay(ctx)
+}
+
+// SwapVolume - struct to store the amount of coins swapped for an (offer, ask) pair over a period
+type SwapVolume struct {
+	Unit        string  `json:"unit"`         // Aggregation unit; day or epoch
+	Period      sdk.Int `json:"period"`       // Index of the day or epoch
+	OfferDenom  string  `json:"offer_denom"`  // Denom of the offered coins
+	AskDenom    string  `json:"ask_denom"`    // Denom of the asked coins
+	OfferAmount sdk.Int `json:"offer_amount"` // Total amount of coins offered
+	AskAmount   sdk.Int `json:"ask_amount"`   // Total amount of coins returned to traders, net of spread fees
+}
+
+// NewSwapVolume creates a SwapVolume instance
+func NewSwapVolume(unit string, period sdk.Int, offerDenom, askDenom string, offerAmount, askAmount sdk.Int) SwapVolume {
+	return SwapVolume{
+		Unit:        unit,
+		Period:      period,
+		OfferDenom:  offerDenom,
+		AskDenom:    askDenom,
+		OfferAmount: offerAmount,
+		AskAmount:   askAmount,
+	}
+}
+
+// String implements fmt.Stringer
+func (sv SwapVolume) String() string {
+	return fmt.Sprintf(`SwapVolume
+	Unit:        %s
+	Period:      %s
+	OfferDenom:  %s
+	AskDenom:    %s
+	OfferAmount: %s
+	AskAmount:   %s`,
+		sv.Unit, sv.Period, sv.OfferDenom, sv.AskDenom, sv.OfferAmount, sv.AskAmount)
+}
+
+// SwapVolumes is a collection of SwapVolume
+type SwapVolumes []SwapVolume
+
+func (v SwapVolumes) String() (out string) {
+	for _, val := range v {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// SwapStats - struct to store the number of swaps and the spread fees collected over a period
+type SwapStats struct {
+	Unit       string    `json:"unit"`        // Aggregation unit; day or epoch
+	Period     sdk.Int   `json:"period"`      // Index of the day or epoch
+	SwapCount  int64     `json:"swap_count"`  // Number of swaps executed
+	SpreadFees sdk.Coins `json:"spread_fees"` // Spread fees charged on the swaps
+}
+
+// NewSwapStats creates a SwapStats instance
+func NewSwapStats(unit string, period sdk.Int, swapCount int64, spreadFees sdk.Coins) SwapStats {
+	return SwapStats{
+		Unit:       unit,
+		Period:     period,
+		SwapCount:  swapCount,
+		SpreadFees: spreadFees,
+	}
+}
+
+// String implements fmt.Stringer
+func (ss SwapStats) String() string {
+	return fmt.Sprintf(`SwapStats
+	Unit:       %s
+	Period:     %s
+	SwapCount:  %d
+	SpreadFees: %s`,
+		ss.Unit, ss.Period, ss.SwapCount, ss.SpreadFees)
+}
+
+// SwapStatsList is a collection of SwapStats
+type SwapStatsList []SwapStats
+
+func (l SwapStatsList) String() (out string) {
+	for _, val := range l {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/terra-project/core/testutil"
+	"github.com/terra-project/core/x/mint"
+)
+
+func TestQueryIssuance(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryIssuance := GetCmdQueryIssuance(cdc)
+
+	// Name check
+	require.Equal(t, mint.QueryIssuance, queryIssuance.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryIssuance.Args))
+
+	// Check Flags
+	denomFlag := queryIssuance.Flag(flagDenom)
+	require.NotNil(t, denomFlag)
+	require.Equal(t, []string{"true"}, denomFlag.Annotations[cobra.BashCompOneRequiredFlag])
+
+	dayFlag := queryIssuance.Flag(flagDay)
+	require.NotNil(t, dayFlag)
+}
+
+func TestQueryIssuanceHistory(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryIssuanceHistory := GetCmdQueryIssuanceHistory(cdc)
+
+	// Name check
+	require.Equal(t, mint.QueryIssuanceHistory, queryIssuanceHistory.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryIssuanceHistory.Args))
+
+	// Check Flags
+	for _, name := range []string{flagDenom, flagFromDay, flagToDay} {
+		flag := queryIssuanceHistory.Flag(name)
+		require.NotNil(t, flag)
+		require.Equal(t, []string{"true"}, flag.Annotations[cobra.BashCompOneRequiredFlag])
+	}
+}
+
+func TestQuerySeigniorage(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	querySeigniorage := GetCmdQuerySeigniorage(cdc)
+
+	// Name check
+	require.Equal(t, mint.QuerySeigniorage, querySeigniorage.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(querySeigniorage.Args))
+
+	// Check Flags
+	epochFlag := querySeigniorage.Flag(flagEpoch)
+	require.NotNil(t, epochFlag)
+}
+
+func TestQueryTotalSupply(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryTotalSupply := GetCmdQueryTotalSupply(cdc)
+
+	// Name check
+	require.Equal(t, mint.QueryTotalSupply, queryTotalSupply.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryTotalSupply.Args))
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terra-project/core/x/mint"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagDenom   = "denom"
+	flagDay     = "day"
+	flagFromDay = "from-day"
+	flagToDay   = "to-day"
+	flagEpoch   = "epoch"
+)
+
+// validateIntFlag checks that an optional flag value is formatted as an integer
+func validateIntFlag(name, value string) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	if _, ok := sdk.NewIntFromString(value); !ok {
+		return fmt.Errorf("the given %s {%s} is not a valid format; %s should be formatted as an integer", name, value, name)
+	}
+
+	return nil
+}
+
+// GetCmdQueryIssuance implements the query issuance command.
+func GetCmdQueryIssuance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   mint.QueryIssuance,
+		Args:  cobra.NoArgs,
+		Short: "Query the issuance of a denom asset",
+		Long: strings.TrimSpace(`
+Query the issuance of a denom asset at the specified day; default is the current day.
+
+$ terracli query mint issuance --denom=ukrw --day=3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+			dayStr := viper.GetString(flagDay)
+			if err := validateIntFlag(flagDay, dayStr); err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", mint.QuerierRoute, mint.QueryIssuance, denom, dayStr), nil)
+			if err != nil {
+				return err
+			}
+
+			var issuance mint.QueryIssuanceResponse
+			cdc.MustUnmarshalJSON(res, &issuance)
+			return cliCtx.PrintOutput(issuance)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "the denom which you want to know the issuance of")
+	cmd.Flags().String(flagDay, "", "(optional) the # of days after genesis time; default is the current day")
+
+	cmd.MarkFlagRequired(flagDenom)
+
+	return cmd
+}
+
+// GetCmdQueryIssuanceHistory implements the query issuance-history command.
+func GetCmdQueryIssuanceHistory(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   mint.QueryIssuanceHistory,
+		Args:  cobra.NoArgs,
+		Short: "Query the daily issuance of a denom asset over a range of days",
+		Long: strings.TrimSpace(`
+Query the daily issuance of a denom asset from one day to another, both inclusive.
+
+$ terracli query mint issuance-history --denom=ukrw --from-day=0 --to-day=30
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+			fromDayStr := viper.GetString(flagFromDay)
+			toDayStr := viper.GetString(flagToDay)
+			if err := validateIntFlag(flagFromDay, fromDayStr); err != nil {
+				return err
+			}
+			if err := validateIntFlag(flagToDay, toDayStr); err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s", mint.QuerierRoute, mint.QueryIssuanceHistory, denom, fromDayStr, toDayStr), nil)
+			if err != nil {
+				return err
+			}
+
+			var history mint.QueryIssuanceHistoryResponse
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "the denom which you want to know the issuance history of")
+	cmd.Flags().String(flagFromDay, "", "the first day of the range, as the # of days after genesis time")
+	cmd.Flags().String(flagToDay, "", "the last day of the range, as the # of days after genesis time")
+
+	cmd.MarkFlagRequired(flagDenom)
+	cmd.MarkFlagRequired(flagFromDay)
+	cmd.MarkFlagRequired(flagToDay)
+
+	return cmd
+}
+
+// GetCmdQuerySeigniorage implements the query seigniorage command.
+func GetCmdQuerySeigniorage(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   mint.QuerySeigniorage,
+		Args:  cobra.NoArgs,
+		Short: "Query the seigniorage minted at an epoch",
+		Long: strings.TrimSpace(`
+Query the seigniorage in uluna minted during the specified epoch; default is the current epoch.
+
+$ terracli query mint seigniorage --epoch=14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			epochStr := viper.GetString(flagEpoch)
+			if err := validateIntFlag(flagEpoch, epochStr); err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", mint.QuerierRoute, mint.QuerySeigniorage, epochStr), nil)
+			if err != nil {
+				return err
+			}
+
+			var seigniorage mint.QuerySeigniorageResponse
+			cdc.MustUnmarshalJSON(res, &seigniorage)
+			return cliCtx.PrintOutput(seigniorage)
+		},
+	}
+
+	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you want to get the seigniorage of; default is current epoch")
+
+	return cmd
+}
+
+// GetCmdQueryTotalSupply implements the query total-supply command.
+func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   mint.QueryTotalSupply,
+		Args:  cobra.NoArgs,
+		Short: "Query the total supply of all denom assets",
+		Long: strings.TrimSpace(`
+Query the total supply of all denom assets at the current day.
+
+$ terracli query mint total-supply
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", mint.QuerierRoute, mint.QueryTotalSupply), nil)
+			if err != nil {
+				return err
+			}
+
+			var totalSupply sdk.Coins
+			cdc.MustUnmarshalJSON(res, &totalSupply)
+			return cliCtx.PrintOutput(totalSupply)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/terra-project/core/x/mint"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(fmt.Sprintf("/mint/%s/{%s}", mint.QueryIssuance, RestDenom), queryIssuanceHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/mint/%s/{%s}/{%s}", mint.QueryIssuance, RestDenom, RestDay), queryIssuanceHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/mint/%s/{%s}/{%s}/{%s}", mint.QueryIssuanceHistory, RestDenom, RestFromDay, RestToDay), queryIssuanceHistoryHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/mint/%s", mint.QuerySeigniorage), querySeigniorageHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/mint/%s/{%s}", mint.QuerySeigniorage, RestEpoch), querySeigniorageHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/mint/%s", mint.QueryTotalSupply), queryTotalSupplyHandlerFunction(cdc, cliCtx)).Methods("GET")
+}
+
+// validateIntVars checks that the given optional path variables are formatted as integers
+func validateIntVars(vars map[string]string, names ...string) error {
+	for _, name := range names {
+		if value := vars[name]; len(value) != 0 {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func queryIssuanceHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if err := validateIntVars(vars, RestDay); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", mint.QuerierRoute, mint.QueryIssuance, vars[RestDenom], vars[RestDay]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryIssuanceHistoryHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if err := validateIntVars(vars, RestFromDay, RestToDay); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s", mint.QuerierRoute, mint.QueryIssuanceHistory,
+			vars[RestDenom], vars[RestFromDay], vars[RestToDay]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func querySeigniorageHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		if err := validateIntVars(vars, RestEpoch); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", mint.QuerierRoute, mint.QuerySeigniorage, vars[RestEpoch]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTotalSupplyHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", mint.QuerierRoute, mint.QueryTotalSupply), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,15 @@
+package mint
+
+const (
+	// ModuleName is the name of the mint module
+	ModuleName = "mint"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the query router key for the mint module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace for params keeper
+	DefaultParamspace = ModuleName
+)
@@ -0,0 +1,23 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes the issuance snapshots that fall out of the retention window at the end of each day.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if !k.ck.IsDayLastBlock(ctx) {
+		return
+	}
+
+	retention := k.GetParams(ctx).IssuanceRetention
+	if retention == 0 {
+		return
+	}
+
+	curDay := k.ck.GetDay(ctx)
+	cutoffDay := curDay.SubRaw(retention)
+	if cutoffDay.IsPositive() {
+		k.PruneIssuanceSnapshots(ctx, cutoffDay)
+	}
+}
@@ -0,0 +1,58 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEndBlockerPruning(t *testing.T) {
+	input := createTestInput(t)
+
+	params := NewParams(3, 3)
+	input.mintKeeper.SetParams(input.ctx, params)
+
+	// One luna mint every day
+	days := int64(10)
+	for day := int64(0); day < days; day++ {
+		ctx := input.ctx.WithBlockHeight(day * util.BlocksPerDay)
+		err := input.mintKeeper.Mint(ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(100)))
+		require.Nil(t, err)
+
+		EndBlocker(ctx.WithBlockHeight((day+1)*util.BlocksPerDay-1), input.mintKeeper)
+	}
+
+	ctx := input.ctx.WithBlockHeight(days * util.BlocksPerDay)
+	cutoffDay := sdk.NewInt(days - 1 - params.IssuanceRetention)
+	require.Equal(t, cutoffDay, input.mintKeeper.GetOldestIssuanceDay(ctx))
+
+	input.mintKeeper.IterateIssuanceSnapshots(ctx, func(denom string, day sdk.Int, issuance sdk.Int) (stop bool) {
+		require.True(t, day.GTE(cutoffDay))
+		return false
+	})
+
+	// Issuance inside the retention window is unchanged, including denoms not touched since genesis
+	for day := cutoffDay.Int64(); day < days; day++ {
+		require.Equal(t, sdk.NewInt(100*(day+1)), input.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(day)))
+		require.Equal(t, uSDRAmount.MulRaw(3), input.mintKeeper.GetIssuance(ctx, assets.MicroSDRDenom, sdk.NewInt(day)))
+	}
+
+	require.NotNil(t, input.mintKeeper.ValidateIssuanceDay(ctx, cutoffDay.Sub(sdk.OneInt())))
+	require.Nil(t, input.mintKeeper.ValidateIssuanceDay(ctx, cutoffDay))
+}
+
+func TestEndBlockerPruningDisabled(t *testing.T) {
+	input := createTestInput(t)
+	input.mintKeeper.SetParams(input.ctx, NewParams(0, 0))
+
+	ctx := input.ctx.WithBlockHeight(10*util.BlocksPerDay - 1)
+	EndBlocker(ctx, input.mintKeeper)
+
+	require.Equal(t, sdk.ZeroInt(), input.mintKeeper.GetOldestIssuanceDay(ctx))
+	require.Equal(t, uSDRAmount.MulRaw(3), input.mintKeeper.GetIssuance(ctx, assets.MicroSDRDenom, sdk.ZeroInt()))
+}
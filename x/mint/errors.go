@@ -0,0 +1,22 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mint error codes
+const (
+	DefaultCodespace sdk.CodespaceType = "mint"
+
+	CodeIssuancePruned sdk.CodeType = 1
+)
+
+// ----------------------------------------
+// Error constructors
+
+// ErrIssuancePruned called when the issuance snapshots of the requested day were pruned from the store
+func ErrIssuancePruned(codespace sdk.CodespaceType, day, oldestDay sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeIssuancePruned, fmt.Sprintf("Issuance of day %s was pruned; the oldest retained day is %s", day, oldestDay))
+}
@@ -0,0 +1,35 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+)
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
+}
+
+// expected fee keeper
+type FeeCollectionKeeper interface {
+	GetCollectedFees(ctx sdk.Context) sdk.Coins
+}
+
+// expected distribution keeper
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distribution.FeePool)
+	GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) (rewards sdk.DecCoins)
+}
+
+// expected oracle keeper
+type OracleKeeper interface {
+	GetSwapFeePool(ctx sdk.Context) (pool sdk.Coins)
+}
+
+// expected calendar keeper
+type CalendarKeeper interface {
+	DaysPerEpoch(ctx sdk.Context) int64
+	GetDay(ctx sdk.Context) sdk.Int
+	GetEpoch(ctx sdk.Context) sdk.Int
+	IsDayLastBlock(ctx sdk.Context) bool
+}
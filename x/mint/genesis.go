@@ -0,0 +1,145 @@
+package mint
+
+import (
+	"fmt"
+
+	"github.com/terra-project/core/types/assets"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GenesisState - all mint state that must be provided at genesis
+type GenesisState struct {
+	Params            Params            `json:"params"`             // mint params
+	Supplies          sdk.Coins         `json:"supplies"`           // current supply of every denom; read from the accounts when empty
+	IssuanceSnapshots IssuanceSnapshots `json:"issuance_snapshots"` // past day snapshots of the issuance
+}
+
+func NewGenesisState(params Params, supplies sdk.Coins, issuanceSnapshots IssuanceSnapshots) GenesisState {
+	return GenesisState{
+		Params:            params,
+		Supplies:          supplies,
+		IssuanceSnapshots: issuanceSnapshots,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:            DefaultParams(),
+		Supplies:          sdk.Coins{},
+		IssuanceSnapshots: IssuanceSnapshots{},
+	}
+}
+
+// InitGenesis initializes the supply record of every denom. A fresh chain has no supplies in its genesis;
+// they are computed once from the genesis account balances, the only place where the mint module
+// reads every account. The luna supply is read from the staking pool, as in the supply invariant.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	// Days before the first exported snapshot were pruned or left out of the export
+	for i, snapshot := range data.IssuanceSnapshots {
+		keeper.setIssuanceSnapshot(ctx, snapshot.Denom, snapshot.Day, snapshot.Issuance)
+		if i == 0 || snapshot.Day.LT(keeper.GetOldestIssuanceDay(ctx)) {
+			keeper.setOldestIssuanceDay(ctx, snapshot.Day)
+		}
+	}
+
+	supplies := data.Supplies
+	if supplies.Empty() {
+		supplies = sdk.Coins{}
+		keeper.ak.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+			for _, coin := range acc.GetCoins() {
+				if coin.Denom != assets.MicroLunaDenom {
+					supplies = supplies.Add(sdk.Coins{coin})
+				}
+			}
+			return false
+		})
+
+		// The staking pool tracks the luna held by accounts, the fee collector and the distribution
+		// module, and the bonded luna; the swap fee pool is empty at genesis
+		pool := keeper.sk.GetPool(ctx)
+		supplies = supplies.Add(sdk.NewCoins(sdk.NewCoin(assets.MicroLunaDenom, pool.NotBondedTokens.Add(pool.BondedTokens))))
+	}
+
+	curDay := keeper.ck.GetDay(ctx)
+	for _, supply := range supplies {
+		keeper.SetSupply(ctx, supply.Denom, supply.Amount)
+		keeper.setIssuanceSnapshot(ctx, supply.Denom, curDay, supply.Amount)
+	}
+
+	keeper.setSupplyInitialized(ctx)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper. The GenesisState
+// contains the issuance snapshots of the last IssuanceLookback days.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	supplies := keeper.GetTotalSupply(ctx)
+
+	curDay := keeper.ck.GetDay(ctx)
+	firstDay := curDay.SubRaw(params.IssuanceLookback)
+	if oldestDay := keeper.GetOldestIssuanceDay(ctx); firstDay.LT(oldestDay) {
+		firstDay = oldestDay
+	}
+
+	// The first day of the window carries the latest snapshot stored on or before it,
+	// so that lookups inside the window resolve as before the export
+	snapshots := IssuanceSnapshots{}
+	keeper.IterateSupplies(ctx, func(denom string, supply sdk.Int) (stop bool) {
+		if firstDay.LT(curDay) {
+			issuance := keeper.GetIssuance(ctx, denom, firstDay)
+			if issuance.IsPositive() {
+				snapshots = append(snapshots, NewIssuanceSnapshot(denom, firstDay, issuance))
+			}
+		}
+		return false
+	})
+
+	keeper.IterateIssuanceSnapshots(ctx, func(denom string, day sdk.Int, issuance sdk.Int) (stop bool) {
+		if day.GT(firstDay) && day.LT(curDay) {
+			snapshots = append(snapshots, NewIssuanceSnapshot(denom, day, issuance))
+		}
+		return false
+	})
+
+	return NewGenesisState(params, supplies, snapshots)
+}
+
+// ValidateGenesis validates the provided mint genesis state to ensure the
+// expected invariants holds. (i.e. params in correct bounds, no duplicate snapshots)
+func ValidateGenesis(data GenesisState) error {
+	if !data.Supplies.IsValid() {
+		return fmt.Errorf("Invalid supplies: %s", data.Supplies)
+	}
+
+	snapshotMap := make(map[string]bool)
+	for _, snapshot := range data.IssuanceSnapshots {
+		if len(snapshot.Denom) == 0 || snapshot.Day.IsNegative() || snapshot.Issuance.IsNegative() {
+			return fmt.Errorf("Invalid issuance snapshot: %s", snapshot)
+		}
+
+		key := string(keyIssuance(snapshot.Denom, snapshot.Day))
+		if _, ok := snapshotMap[key]; ok {
+			return fmt.Errorf("Duplicate issuance snapshot for %s at day %s", snapshot.Denom, snapshot.Day)
+		}
+		snapshotMap[key] = true
+	}
+
+	return validateParams(data.Params)
+}
+
+// ValidateGenesisCalendar validates the provided mint genesis state against the epoch length of the
+// calendar. The issuance lookback must span at least an epoch, so that the seigniorage of the current
+// epoch can be computed after an export.
+func ValidateGenesisCalendar(data GenesisState, daysPerEpoch int64) error {
+	if data.Params.IssuanceLookback < daysPerEpoch {
+		return fmt.Errorf("mint issuance lookback %d should not be shorter than an epoch of %d days",
+			data.Params.IssuanceLookback, daysPerEpoch)
+	}
+
+	return nil
+}
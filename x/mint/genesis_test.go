@@ -0,0 +1,103 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input := createTestInput(t)
+
+	params := NewParams(5, 5)
+	input.mintKeeper.SetParams(input.ctx, params)
+
+	// One luna mint every other day
+	days := int64(10)
+	for day := int64(0); day < days; day += 2 {
+		ctx := input.ctx.WithBlockHeight(day * util.BlocksPerDay)
+		err := input.mintKeeper.Mint(ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(100)))
+		require.Nil(t, err)
+	}
+
+	ctx := input.ctx.WithBlockHeight(days * util.BlocksPerDay)
+	genesis := ExportGenesis(ctx, input.mintKeeper)
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Equal(t, params, genesis.Params)
+	require.Equal(t, sdk.NewInt(500), genesis.Supplies.AmountOf(assets.MicroLunaDenom))
+	require.Equal(t, uSDRAmount.MulRaw(3), genesis.Supplies.AmountOf(assets.MicroSDRDenom))
+
+	// Snapshots older than the lookback are dropped
+	for _, snapshot := range genesis.IssuanceSnapshots {
+		require.True(t, snapshot.Day.GTE(sdk.NewInt(days-params.IssuanceLookback)))
+	}
+
+	// The new chain restarts at height 0 and carries on with the exported days
+	calendarGenesis := calendar.ExportGenesis(ctx, input.calendarKeeper)
+	newInput := createTestInput(t)
+	newCtx := newInput.ctx.WithBlockHeight(0)
+	calendar.InitGenesis(newCtx, newInput.calendarKeeper, calendarGenesis)
+	InitGenesis(newCtx, newInput.mintKeeper, genesis)
+	require.Equal(t, sdk.NewInt(days), newInput.calendarKeeper.GetDay(newCtx))
+
+	for day := days - params.IssuanceLookback; day <= days; day++ {
+		require.Equal(t,
+			input.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(day)),
+			newInput.mintKeeper.GetIssuance(newCtx, assets.MicroLunaDenom, sdk.NewInt(day)))
+	}
+
+	require.Equal(t, input.mintKeeper.GetTotalSupply(ctx), newInput.mintKeeper.GetTotalSupply(newCtx))
+}
+
+func TestInitGenesisLunaSupply(t *testing.T) {
+	input := createTestInput(t)
+
+	// Luna is read from the staking pool, not from the accounts
+	pool := input.mintKeeper.sk.GetPool(input.ctx)
+	pool.NotBondedTokens = sdk.NewInt(100)
+	pool.BondedTokens = sdk.NewInt(50)
+	input.mintKeeper.sk.SetPool(input.ctx, pool)
+
+	InitGenesis(input.ctx, input.mintKeeper, DefaultGenesisState())
+	require.True(t, input.mintKeeper.IsSupplyInitialized(input.ctx))
+	require.Equal(t, sdk.NewInt(150), input.mintKeeper.GetSupply(input.ctx, assets.MicroLunaDenom))
+	require.Equal(t, uSDRAmount.MulRaw(3), input.mintKeeper.GetSupply(input.ctx, assets.MicroSDRDenom))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.IssuanceSnapshots = IssuanceSnapshots{
+		NewIssuanceSnapshot(assets.MicroLunaDenom, sdk.OneInt(), sdk.NewInt(100)),
+		NewIssuanceSnapshot(assets.MicroLunaDenom, sdk.OneInt(), sdk.NewInt(200)),
+	}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.IssuanceSnapshots = IssuanceSnapshots{
+		NewIssuanceSnapshot(assets.MicroLunaDenom, sdk.OneInt().Neg(), sdk.NewInt(100)),
+	}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.IssuanceLookback = -1
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.IssuanceRetention = genesis.Params.IssuanceLookback - 1
+	require.Error(t, ValidateGenesis(genesis))
+}
+
+func TestValidateGenesisCalendar(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesisCalendar(genesis, 7))
+
+	genesis.Params.IssuanceLookback = 6
+	require.Error(t, ValidateGenesisCalendar(genesis, 7))
+}
@@ -0,0 +1,79 @@
+package mint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/terra-project/core/types/assets"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// RegisterInvariants registers all mint invariants
+func RegisterInvariants(c CrisisKeeper, k Keeper, fck FeeCollectionKeeper, dk DistributionKeeper, ok OracleKeeper) {
+	c.RegisterRoute(ModuleName, "supply", SupplyInvariant(k, fck, dk, ok))
+}
+
+// SupplyInvariant checks that the issuance of the current day matches the coins held on the network
+// for every denom.
+func SupplyInvariant(k Keeper, fck FeeCollectionKeeper, dk DistributionKeeper, ok OracleKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		held := heldSupply(ctx, k, fck, dk, ok)
+		swapFeePool := ok.GetSwapFeePool(ctx)
+
+		denoms := []string{assets.MicroLunaDenom}
+		for _, coin := range held {
+			if coin.Denom != assets.MicroLunaDenom {
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+		sort.Strings(denoms)
+
+		curDay := k.ck.GetDay(ctx)
+		for _, denom := range denoms {
+			issuance := sdk.NewDecFromInt(k.GetIssuance(ctx, denom, curDay))
+
+			amount := held.AmountOf(denom)
+			if !issuance.Equal(amount) {
+				return fmt.Errorf("issuance of %s does not match the held supply:\n"+
+					"\tissuance: %v\n"+
+					"\theld supply (incl. swap fee pool %s): %v", denom, issuance, swapFeePool.AmountOf(denom), amount)
+			}
+		}
+
+		return nil
+	}
+}
+
+// heldSupply returns the coins held on the network. Coins are held by accounts, the staking pools, the fee
+// collector, the distribution module and the oracle swap fee pool; swap fees are issued as they enter the pool.
+// Luna is tracked by the staking pool, except for the luna in the swap fee pool.
+func heldSupply(ctx sdk.Context, k Keeper, fck FeeCollectionKeeper, dk DistributionKeeper, ok OracleKeeper) (held sdk.DecCoins) {
+	k.ak.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		held = held.Add(sdk.NewDecCoins(acc.GetCoins()))
+		return false
+	})
+
+	held = held.Add(sdk.NewDecCoins(fck.GetCollectedFees(ctx)))
+	held = held.Add(dk.GetFeePool(ctx).CommunityPool)
+	k.sk.IterateValidators(ctx, func(_ int64, val sdk.Validator) (stop bool) {
+		held = held.Add(dk.GetValidatorOutstandingRewards(ctx, val.GetOperator()))
+		return false
+	})
+
+	swapFeePool := ok.GetSwapFeePool(ctx)
+	held = held.Add(sdk.NewDecCoins(swapFeePool))
+
+	pool := k.sk.GetPool(ctx)
+	lunaHeld := sdk.NewDecCoin(assets.MicroLunaDenom, pool.NotBondedTokens.Add(pool.BondedTokens).Add(swapFeePool.AmountOf(assets.MicroLunaDenom)))
+
+	supply := sdk.DecCoins{}
+	for _, coin := range held {
+		if coin.Denom != assets.MicroLunaDenom {
+			supply = append(supply, coin)
+		}
+	}
+
+	return supply.Add(sdk.DecCoins{lunaHeld})
+}
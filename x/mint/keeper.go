@@ -1,19 +1,19 @@
 package mint
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
-// StoreKey is string representation of the store key for mint
-const StoreKey = "mint"
-
 // Keeper is an instance of the Mint keeper module.
 // Adds / subtracts balances from accounts and maintains a global state
 // of issuance of currencies on the Terra network.
@@ -23,16 +23,22 @@ type Keeper struct {
 	sk  staking.Keeper
 	bk  bank.Keeper
 	ak  auth.AccountKeeper
+	ck  CalendarKeeper
+
+	paramSpace params.Subspace
 }
 
 // NewKeeper creates a new instance of the mint module.
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, bk bank.Keeper, ak auth.AccountKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk staking.Keeper, bk bank.Keeper, ak auth.AccountKeeper,
+	ck CalendarKeeper, paramspace params.Subspace) Keeper {
 	return Keeper{
-		cdc: cdc,
-		key: key,
-		sk:  sk,
-		bk:  bk,
-		ak:  ak,
+		cdc:        cdc,
+		key:        key,
+		sk:         sk,
+		bk:         bk,
+		ak:         ak,
+		ck:         ck,
+		paramSpace: paramspace.WithKeyTable(paramKeyTable()),
 	}
 }
 
@@ -45,9 +51,7 @@ func (k Keeper) Mint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) (
 	}
 
 	if coin.Denom == assets.MicroLunaDenom {
-		pool := k.sk.GetPool(ctx)
-		pool.NotBondedTokens = pool.NotBondedTokens.Add(coin.Amount)
-		k.sk.SetPool(ctx, pool)
+		k.ChangeNotBondedTokens(ctx, coin.Amount)
 	}
 
 	return k.ChangeIssuance(ctx, coin.Denom, coin.Amount)
@@ -61,95 +65,297 @@ func (k Keeper) Burn(ctx sdk.Context, payer sdk.AccAddress, coin sdk.Coin) (err
 	}
 
 	if coin.Denom == assets.MicroLunaDenom {
-		pool := k.sk.GetPool(ctx)
-		pool.NotBondedTokens = pool.NotBondedTokens.Sub(coin.Amount)
-		k.sk.SetPool(ctx, pool)
+		k.ChangeNotBondedTokens(ctx, coin.Amount.Neg())
 	}
 
 	return k.ChangeIssuance(ctx, coin.Denom, coin.Amount.Neg())
 }
 
-// ChangeIssuance updates the issuance to reflect
+// ChangeNotBondedTokens updates the not bonded tokens of the staking pool by {delta}. The pool tracks the luna
+// held by accounts, the fee collector and the distribution module; luna moving in or out of balances the pool
+// does not track, such as the oracle swap fee pool, must be reflected here.
+func (k Keeper) ChangeNotBondedTokens(ctx sdk.Context, delta sdk.Int) {
+	pool := k.sk.GetPool(ctx)
+	pool.NotBondedTokens = pool.NotBondedTokens.Add(delta)
+	k.sk.SetPool(ctx, pool)
+}
+
+// ChangeIssuance updates the supply record of {denom} by {delta}, and snapshots the new supply for the current day
 func (k Keeper) ChangeIssuance(ctx sdk.Context, denom string, delta sdk.Int) (err sdk.Error) {
+	newSupply := k.GetSupply(ctx, denom).Add(delta)
+	if newSupply.IsNegative() {
+		return sdk.ErrInternal("Issuance should never fall below 0")
+	}
+
+	curDay := k.ck.GetDay(ctx)
+	k.SetSupply(ctx, denom, newSupply)
+	k.setIssuanceSnapshot(ctx, denom, curDay, newSupply)
+
+	return
+}
+
+// GetIssuance fetches the total issuance count of the coin matching {denom} at the end of {day}.
+// For the current day, returns the supply record. If the {day} applies to a previous period,
+// fetches the last snapshot stored on or before the day; days on or after the latest snapshot
+// are read directly. Days before the oldest retained day cannot be resolved; callers serving
+// arbitrary days should check GetOldestIssuanceDay first.
+func (k Keeper) GetIssuance(ctx sdk.Context, denom string, day sdk.Int) (issuance sdk.Int) {
+	curDay := k.ck.GetDay(ctx)
+	if day.GTE(curDay) {
+		return k.GetSupply(ctx, denom)
+	}
+
 	store := ctx.KVStore(k.key)
-	curDay := sdk.NewInt(ctx.BlockHeight() / util.BlocksPerDay)
+	if latestDay, found := k.getLatestIssuanceDay(ctx, denom); found && day.GTE(latestDay) {
+		bz := store.Get(keyIssuance(denom, latestDay))
+		if bz == nil {
+			return sdk.ZeroInt()
+		}
 
-	// If genesis issuance is not on disk, GetIssuance will do a fresh read of account balances
-	// and the change in issuance should be reported automatically.
-	if !store.Has(keyIssuance(denom, sdk.ZeroInt())) {
-		k.GetIssuance(ctx, denom, curDay)
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuance)
 		return
 	}
 
-	curIssuance := k.GetIssuance(ctx, denom, curDay)
-	newIssuance := curIssuance.Add(delta)
+	oldestDay := k.GetOldestIssuanceDay(ctx)
+	for d := day; d.GTE(oldestDay); d = d.Sub(sdk.OneInt()) {
+		if bz := store.Get(keyIssuance(denom, d)); bz != nil {
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuance)
+			return
+		}
+	}
+
+	// The denom was not issued yet
+	return sdk.ZeroInt()
+}
 
-	if newIssuance.IsNegative() {
-		err = sdk.ErrInternal("Issuance should never fall below 0")
-	} else {
-		bz := k.cdc.MustMarshalBinaryLengthPrefixed(newIssuance)
-		store.Set(keyIssuance(denom, curDay), bz)
+// setIssuanceSnapshot stores the issuance of {denom} at the end of {day}
+func (k Keeper) setIssuanceSnapshot(ctx sdk.Context, denom string, day sdk.Int, issuance sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(issuance)
+	store.Set(keyIssuance(denom, day), bz)
+
+	if latestDay, found := k.getLatestIssuanceDay(ctx, denom); !found || day.GT(latestDay) {
+		k.setLatestIssuanceDay(ctx, denom, day)
+	}
+}
+
+// getLatestIssuanceDay returns the day of the latest issuance snapshot of {denom}
+func (k Keeper) getLatestIssuanceDay(ctx sdk.Context, denom string) (day sdk.Int, found bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keyLatestIssuanceDay(denom))
+	if bz == nil {
+		return sdk.ZeroInt(), false
 	}
 
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &day)
+	return day, true
+}
+
+// setLatestIssuanceDay sets the day of the latest issuance snapshot of {denom}
+func (k Keeper) setLatestIssuanceDay(ctx sdk.Context, denom string, day sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(day)
+	store.Set(keyLatestIssuanceDay(denom), bz)
+}
+
+// deleteIssuanceSnapshot removes the issuance snapshot of {denom} at the end of {day}
+func (k Keeper) deleteIssuanceSnapshot(ctx sdk.Context, denom string, day sdk.Int) {
+	store := ctx.KVStore(k.key)
+	store.Delete(keyIssuance(denom, day))
+}
+
+// GetOldestIssuanceDay returns the first day whose issuance is still resolvable from the store
+func (k Keeper) GetOldestIssuanceDay(ctx sdk.Context) (day sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keyOldestIssuanceDay)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &day)
 	return
 }
 
-// GetIssuance fetches the total issuance count of the coin matching {denom}. If the {day} applies
-// to a previous period, fetches the last stored snapshot issuance of the coin. For virgin calls,
-// iterates through the accountkeeper and computes the genesis issuance.
-func (k Keeper) GetIssuance(ctx sdk.Context, denom string, day sdk.Int) (issuance sdk.Int) {
+// setOldestIssuanceDay sets the first day whose issuance is still resolvable from the store
+func (k Keeper) setOldestIssuanceDay(ctx sdk.Context, day sdk.Int) {
 	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(day)
+	store.Set(keyOldestIssuanceDay, bz)
+}
 
-	if bz := store.Get(keyIssuance(denom, day)); bz != nil {
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuance)
-	} else {
-		// Genesis epoch; nothing exists in store so we must read it
-		// from accountkeeper
-		if day.LTE(sdk.ZeroInt()) {
-			issuance = sdk.ZeroInt()
-			countIssuance := func(acc auth.Account) (stop bool) {
-				issuance = issuance.Add(acc.GetCoins().AmountOf(denom))
-				return false
-			}
-			k.ak.IterateAccounts(ctx, countIssuance)
-		} else {
-			// Fetch the issuance snapshot of the previous epoch
-			issuance = k.GetIssuance(ctx, denom, day.Sub(sdk.OneInt()))
+// PruneIssuanceSnapshots deletes the issuance snapshots of every day before {cutoffDay}. The issuance
+// at the cutoff day is snapshotted first, so that later days keep resolving to the same issuance.
+func (k Keeper) PruneIssuanceSnapshots(ctx sdk.Context, cutoffDay sdk.Int) {
+	if cutoffDay.LTE(k.GetOldestIssuanceDay(ctx)) {
+		return
+	}
+
+	type snapshotKey struct {
+		denom string
+		day   sdk.Int
+	}
+
+	var pruned []snapshotKey
+	denomSet := map[string]bool{}
+	k.IterateIssuanceSnapshots(ctx, func(denom string, day sdk.Int, issuance sdk.Int) (stop bool) {
+		if day.LT(cutoffDay) {
+			pruned = append(pruned, snapshotKey{denom, day})
+			denomSet[denom] = true
 		}
+		return false
+	})
 
-		// Set issuance to the store
-		store := ctx.KVStore(k.key)
-		bz := k.cdc.MustMarshalBinaryLengthPrefixed(issuance)
-		store.Set(keyIssuance(denom, day), bz)
+	denoms := make([]string, 0, len(denomSet))
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
 	}
+	sort.Strings(denoms)
 
+	// Carry the issuance over to the cutoff day before deleting the older snapshots
+	for _, denom := range denoms {
+		if issuance := k.GetIssuance(ctx, denom, cutoffDay); issuance.IsPositive() {
+			k.setIssuanceSnapshot(ctx, denom, cutoffDay, issuance)
+		}
+	}
+
+	for _, key := range pruned {
+		k.deleteIssuanceSnapshot(ctx, key.denom, key.day)
+	}
+
+	k.setOldestIssuanceDay(ctx, cutoffDay)
+}
+
+// GetSupply returns the current total supply of {denom}
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) (supply sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keySupply(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
 	return
 }
 
+// SetSupply sets the current total supply of {denom}
+func (k Keeper) SetSupply(ctx sdk.Context, denom string, supply sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(supply)
+	store.Set(keySupply(denom), bz)
+}
+
+// IterateSupplies iterates over the supply records of all denoms
+func (k Keeper) IterateSupplies(ctx sdk.Context, handler func(denom string, supply sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixSupply)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := strings.TrimPrefix(string(iter.Key()), string(prefixSupply)+":")
+
+		var supply sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &supply)
+		if handler(denom, supply) {
+			break
+		}
+	}
+}
+
 // PeekEpochSeigniorage retrieves the size of the seigniorage pool at epoch
 func (k Keeper) PeekEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) (epochSeigniorage sdk.Int) {
+	prevEpochLastDay, epochLastDay := k.epochIssuanceDays(ctx, epoch)
 
-	daysPerEpoch := util.BlocksPerEpoch / util.BlocksPerDay
-	epochLastDay := epoch.Add(sdk.OneInt()).MulRaw(daysPerEpoch).Sub(sdk.OneInt())
+	prevEpochIssuance := k.GetIssuance(ctx, assets.MicroLunaDenom, prevEpochLastDay)
+	epochIssuance := k.GetIssuance(ctx, assets.MicroLunaDenom, epochLastDay)
+	epochSeigniorage = prevEpochIssuance.Sub(epochIssuance)
+
+	if epochSeigniorage.LT(sdk.ZeroInt()) {
+		epochSeigniorage = sdk.ZeroInt()
+	}
+
+	return
+}
+
+// epochIssuanceDays returns the days whose issuance difference makes up the seigniorage of {epoch}
+func (k Keeper) epochIssuanceDays(ctx sdk.Context, epoch sdk.Int) (prevEpochLastDay, epochLastDay sdk.Int) {
+	daysPerEpoch := k.ck.DaysPerEpoch(ctx)
+	epochLastDay = epoch.Add(sdk.OneInt()).MulRaw(daysPerEpoch).Sub(sdk.OneInt())
 
-	//fmt.Println(epochLastDay)
-	today := sdk.NewInt(ctx.BlockHeight() / util.BlocksPerDay)
+	today := k.ck.GetDay(ctx)
 	if epochLastDay.GT(today) {
 		epochLastDay = today
 	}
 
-	prevEpochLastDay := epochLastDay.SubRaw(daysPerEpoch)
+	prevEpochLastDay = epochLastDay.SubRaw(daysPerEpoch)
 	if prevEpochLastDay.IsNegative() {
 		prevEpochLastDay = sdk.ZeroInt()
 	}
 
-	prevEpochIssuance := k.GetIssuance(ctx, assets.MicroLunaDenom, prevEpochLastDay)
-	epochIssuance := k.GetIssuance(ctx, assets.MicroLunaDenom, epochLastDay)
-	epochSeigniorage = prevEpochIssuance.Sub(epochIssuance)
+	return
+}
 
-	if epochSeigniorage.LT(sdk.ZeroInt()) {
-		epochSeigniorage = sdk.ZeroInt()
+// ValidateIssuanceDay returns an error if the issuance snapshots of {day} were pruned
+func (k Keeper) ValidateIssuanceDay(ctx sdk.Context, day sdk.Int) sdk.Error {
+	if oldestDay := k.GetOldestIssuanceDay(ctx); day.LT(oldestDay) {
+		return ErrIssuancePruned(DefaultCodespace, day, oldestDay)
 	}
 
+	return nil
+}
+
+// ValidateEpochSeigniorage returns an error if the seigniorage of {epoch} can no longer
+// be computed because the issuance snapshots it reads were pruned
+func (k Keeper) ValidateEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) sdk.Error {
+	prevEpochLastDay, _ := k.epochIssuanceDays(ctx, epoch)
+	return k.ValidateIssuanceDay(ctx, prevEpochLastDay)
+}
+
+// IterateIssuanceSnapshots iterates over the day snapshots of all denoms
+func (k Keeper) IterateIssuanceSnapshots(ctx sdk.Context, handler func(denom string, day sdk.Int, issuance sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixIssuance)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// Key format is issuance:{denom}:{day}
+		parts := strings.Split(string(iter.Key()), ":")
+		if len(parts) != 3 {
+			continue
+		}
+
+		day, ok := sdk.NewIntFromString(parts[2])
+		if !ok {
+			continue
+		}
+
+		var issuance sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &issuance)
+		if handler(parts[1], day, issuance) {
+			break
+		}
+	}
+}
+
+// GetTotalSupply returns the current supply of every denom issued on the network
+func (k Keeper) GetTotalSupply(ctx sdk.Context) (totalSupply sdk.Coins) {
+	totalSupply = sdk.Coins{}
+	k.IterateSupplies(ctx, func(denom string, supply sdk.Int) (stop bool) {
+		totalSupply = totalSupply.Add(sdk.NewCoins(sdk.NewCoin(denom, supply)))
+		return false
+	})
+
 	return
 }
+
+//-----------------------------------
+// Params logic
+
+// GetParams get mint params from the global param store
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	var resultParams Params
+	k.paramSpace.Get(ctx, paramStoreKeyParams, &resultParams)
+	return resultParams
+}
+
+// SetParams set mint params from the global param store
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramSpace.Set(ctx, paramStoreKeyParams, &params)
+}
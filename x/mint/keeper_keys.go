@@ -4,18 +4,41 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // nolint
 var (
+	paramStoreKeyParams = []byte("params")
+
 	prefixIssuance        = []byte("issuance")
 	prefixSeignioragePool = []byte("seigniorage_pool")
+	prefixSupply          = []byte("supply")
+
+	prefixLatestIssuanceDay = []byte("latest_issuance_day")
+
+	keyOldestIssuanceDay = []byte("oldest_issuance_day")
+	keySupplyMigrated    = []byte("migrated_supply")
 )
 
 func keyIssuance(denom string, day sdk.Int) []byte {
 	return []byte(fmt.Sprintf("%s:%s:%s", prefixIssuance, denom, day))
 }
 
+func keyLatestIssuanceDay(denom string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", prefixLatestIssuanceDay, denom))
+}
+
+func keySupply(denom string) []byte {
+	return []byte(fmt.Sprintf("%s:%s", prefixSupply, denom))
+}
+
 func keySeignioragePool(epoch sdk.Int) []byte {
 	return []byte(fmt.Sprintf("%s:%s", prefixSeignioragePool, epoch))
 }
+
+func paramKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		paramStoreKeyParams, Params{},
+	)
+}
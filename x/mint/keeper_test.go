@@ -8,6 +8,7 @@ import (
 
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
 
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -36,10 +37,11 @@ var (
 )
 
 type testInput struct {
-	ctx        sdk.Context
-	accKeeper  auth.AccountKeeper
-	bankKeeper bank.Keeper
-	mintKeeper Keeper
+	ctx            sdk.Context
+	accKeeper      auth.AccountKeeper
+	bankKeeper     bank.Keeper
+	calendarKeeper calendar.Keeper
+	mintKeeper     Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -58,6 +60,7 @@ func createTestInput(t *testing.T) testInput {
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyMint := sdk.NewKVStoreKey(StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 
@@ -70,6 +73,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeTransient, db)
 
@@ -99,12 +103,17 @@ func createTestInput(t *testing.T) testInput {
 	stakingKeeper.SetPool(ctx, staking.InitialPool())
 	stakingKeeper.SetParams(ctx, staking.DefaultParams())
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(DefaultParamspace),
 	)
 
 	for _, addr := range addrs {
@@ -112,7 +121,9 @@ func createTestInput(t *testing.T) testInput {
 		require.NoError(t, err)
 	}
 
-	return testInput{ctx, accKeeper, bankKeeper, mintKeeper}
+	InitGenesis(ctx, mintKeeper, DefaultGenesisState())
+
+	return testInput{ctx, accKeeper, bankKeeper, calendarKeeper, mintKeeper}
 }
 
 func TestKeeperIssuance(t *testing.T) {
@@ -192,6 +203,27 @@ func TestKeeperSeigniorage(t *testing.T) {
 	require.Equal(t, sdk.NewInt(100), seigniorage)
 }
 
+func TestKeeperSeigniorageCustomCalendar(t *testing.T) {
+	input := createTestInput(t)
+
+	// Days of 10 blocks, epochs of 3 days
+	calendarParams := calendar.DefaultParams()
+	calendarParams.BlocksPerDay = 10
+	calendarParams.BlocksPerEpoch = 30
+	input.calendarKeeper.SetParams(input.ctx, calendarParams)
+
+	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(100)))
+	input.mintKeeper.Burn(input.ctx.WithBlockHeight(29), addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(60)))
+	input.mintKeeper.Burn(input.ctx.WithBlockHeight(30), addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(40)))
+
+	// The burn at the last block of epoch 0 falls into day 2, the one at the first block of epoch 1 into day 3
+	require.Equal(t, sdk.NewInt(40), input.mintKeeper.GetIssuance(input.ctx.WithBlockHeight(59), assets.MicroLunaDenom, sdk.NewInt(2)))
+	require.True(t, input.mintKeeper.GetIssuance(input.ctx.WithBlockHeight(59), assets.MicroLunaDenom, sdk.NewInt(3)).IsZero())
+
+	require.Equal(t, sdk.NewInt(60), input.mintKeeper.PeekEpochSeigniorage(input.ctx.WithBlockHeight(59), sdk.NewInt(0)))
+	require.Equal(t, sdk.NewInt(40), input.mintKeeper.PeekEpochSeigniorage(input.ctx.WithBlockHeight(59), sdk.NewInt(1)))
+}
+
 func TestKeeperMintStress(t *testing.T) {
 	input := createTestInput(t)
 	rand.Seed(int64(time.Now().Nanosecond()))
@@ -243,3 +275,27 @@ func TestKeeperMintStress(t *testing.T) {
 		}
 	}
 }
+
+func TestKeeperSupply(t *testing.T) {
+	input := createTestInput(t)
+
+	// Genesis balances are recorded
+	require.Equal(t, uSDRAmount.MulRaw(3), input.mintKeeper.GetSupply(input.ctx, assets.MicroSDRDenom))
+	require.Equal(t, sdk.ZeroInt(), input.mintKeeper.GetSupply(input.ctx, assets.MicroKRWDenom))
+
+	// Supply follows mints and burns without reading accounts
+	ctx := input.ctx.WithBlockHeight(2 * util.BlocksPerDay)
+	err := input.mintKeeper.Mint(ctx, addrs[0], sdk.NewCoin(assets.MicroKRWDenom, sdk.NewInt(100)))
+	require.Nil(t, err)
+	err = input.mintKeeper.Burn(ctx, addrs[0], sdk.NewCoin(assets.MicroKRWDenom, sdk.NewInt(40)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(60), input.mintKeeper.GetSupply(ctx, assets.MicroKRWDenom))
+
+	// Day snapshots are derived from the supply record
+	require.Equal(t, sdk.ZeroInt(), input.mintKeeper.GetIssuance(ctx, assets.MicroKRWDenom, sdk.OneInt()))
+	require.Equal(t, sdk.NewInt(60), input.mintKeeper.GetIssuance(ctx.WithBlockHeight(5*util.BlocksPerDay), assets.MicroKRWDenom, sdk.NewInt(4)))
+
+	totalSupply := input.mintKeeper.GetTotalSupply(ctx)
+	require.Equal(t, sdk.NewInt(60), totalSupply.AmountOf(assets.MicroKRWDenom))
+	require.Equal(t, uSDRAmount.MulRaw(3), totalSupply.AmountOf(assets.MicroSDRDenom))
+}
@@ -0,0 +1,33 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsSupplyInitialized returns true once the supply records were initialized, either at genesis or by MigrateSupply
+func (k Keeper) IsSupplyInitialized(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(keySupplyMigrated)
+}
+
+// setSupplyInitialized flags the supply records as initialized, so that the migration runs once
+func (k Keeper) setSupplyInitialized(ctx sdk.Context) {
+	store := ctx.KVStore(k.key)
+	store.Set(keySupplyMigrated, []byte{1})
+}
+
+// MigrateSupply initializes the supply records of a chain that started before they existed. The legacy
+// snapshots only counted account balances, so the supply of every denom is read from the coins held on
+// the network, as checked by SupplyInvariant. Past snapshots are kept as they are.
+func (k Keeper) MigrateSupply(ctx sdk.Context, fck FeeCollectionKeeper, dk DistributionKeeper, ok OracleKeeper) {
+	curDay := k.ck.GetDay(ctx)
+
+	for _, coin := range heldSupply(ctx, k, fck, dk, ok) {
+		supply := coin.Amount.TruncateInt()
+
+		k.SetSupply(ctx, coin.Denom, supply)
+		k.setIssuanceSnapshot(ctx, coin.Denom, curDay, supply)
+	}
+
+	k.setSupplyInitialized(ctx)
+}
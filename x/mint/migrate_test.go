@@ -0,0 +1,53 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateSupply(t *testing.T) {
+	input := createTestInput(t)
+	require.True(t, input.mintKeeper.IsSupplyInitialized(input.ctx))
+
+	// Emulate a store written before supply records existed; the legacy snapshots only counted account
+	// balances, so luna was snapshotted on day 0 and day 3 without the bonded stake
+	store := input.ctx.KVStore(input.mintKeeper.key)
+	store.Delete(keySupply(assets.MicroSDRDenom))
+	store.Delete(keyIssuance(assets.MicroSDRDenom, sdk.ZeroInt()))
+	store.Delete(keyLatestIssuanceDay(assets.MicroSDRDenom))
+	store.Delete(keySupplyMigrated)
+	input.mintKeeper.setIssuanceSnapshot(input.ctx, assets.MicroLunaDenom, sdk.ZeroInt(), sdk.NewInt(1000))
+	input.mintKeeper.setIssuanceSnapshot(input.ctx, assets.MicroLunaDenom, sdk.NewInt(3), sdk.NewInt(900))
+	require.False(t, input.mintKeeper.IsSupplyInitialized(input.ctx))
+
+	// Staked luna, fees, the community pool and swap fees are held outside of the accounts
+	pool := input.mintKeeper.sk.GetPool(input.ctx)
+	pool.NotBondedTokens = sdk.NewInt(400)
+	pool.BondedTokens = sdk.NewInt(500)
+	input.mintKeeper.sk.SetPool(input.ctx, pool)
+
+	fck := dummyFeeCollectionKeeper{sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 10))}
+	dk := dummyDistributionKeeper{sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin(assets.MicroSDRDenom, 5)))}
+	ok := dummyOracleKeeper{sdk.NewCoins(sdk.NewInt64Coin(assets.MicroKRWDenom, 7), sdk.NewInt64Coin(assets.MicroLunaDenom, 3))}
+
+	ctx := input.ctx.WithBlockHeight(5 * util.BlocksPerDay)
+	input.mintKeeper.MigrateSupply(ctx, fck, dk, ok)
+	require.True(t, input.mintKeeper.IsSupplyInitialized(ctx))
+
+	require.Equal(t, sdk.NewInt(903), input.mintKeeper.GetSupply(ctx, assets.MicroLunaDenom))
+	require.Equal(t, uSDRAmount.MulRaw(3).AddRaw(15), input.mintKeeper.GetSupply(ctx, assets.MicroSDRDenom))
+	require.Equal(t, sdk.NewInt(7), input.mintKeeper.GetSupply(ctx, assets.MicroKRWDenom))
+
+	// The migrated supply holds the supply invariant
+	require.Nil(t, SupplyInvariant(input.mintKeeper, fck, dk, ok)(ctx))
+
+	// Past snapshots are kept
+	require.Equal(t, sdk.NewInt(1000), input.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(2)))
+	require.Equal(t, sdk.NewInt(900), input.mintKeeper.GetIssuance(ctx, assets.MicroLunaDenom, sdk.NewInt(4)))
+}
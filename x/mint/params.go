@@ -0,0 +1,57 @@
+package mint
+
+import (
+	"fmt"
+
+	"github.com/terra-project/core/x/calendar"
+)
+
+// DefaultIssuanceLookback covers the long treasury window of 52 epochs of the default calendar plus
+// the epoch before it, which PeekEpochSeigniorage reads for the first epoch of the window
+var DefaultIssuanceLookback = 53 * calendar.DefaultParams().DaysPerEpoch()
+
+// DefaultIssuanceRetention keeps exactly the snapshots that genesis export carries over
+var DefaultIssuanceRetention = DefaultIssuanceLookback
+
+// Params mint parameters
+type Params struct {
+	IssuanceLookback  int64 `json:"issuance_lookback"`  // number of past days of issuance snapshots carried over by genesis export
+	IssuanceRetention int64 `json:"issuance_retention"` // number of past days of issuance snapshots kept in the store; 0 keeps every snapshot
+}
+
+// NewParams creates a new param instance
+func NewParams(issuanceLookback, issuanceRetention int64) Params {
+	return Params{
+		IssuanceLookback:  issuanceLookback,
+		IssuanceRetention: issuanceRetention,
+	}
+}
+
+// DefaultParams creates default mint module parameters
+func DefaultParams() Params {
+	return NewParams(DefaultIssuanceLookback, DefaultIssuanceRetention)
+}
+
+func validateParams(params Params) error {
+	if params.IssuanceLookback < 0 {
+		return fmt.Errorf("mint issuance lookback should be non-negative, is %d", params.IssuanceLookback)
+	}
+
+	if params.IssuanceRetention < 0 {
+		return fmt.Errorf("mint issuance retention should be non-negative, is %d", params.IssuanceRetention)
+	}
+
+	if params.IssuanceRetention != 0 && params.IssuanceRetention < params.IssuanceLookback {
+		return fmt.Errorf("mint issuance retention %d should not be shorter than the issuance lookback %d",
+			params.IssuanceRetention, params.IssuanceLookback)
+	}
+
+	return nil
+}
+
+func (params Params) String() string {
+	return fmt.Sprintf(`mint Params:
+	IssuanceLookback: %d
+	IssuanceRetention: %d
+  `, params.IssuanceLookback, params.IssuanceRetention)
+}
@@ -0,0 +1,190 @@
+package mint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the mint Querier
+const (
+	QueryIssuance        = "issuance"
+	QueryIssuanceHistory = "issuance-history"
+	QuerySeigniorage     = "seigniorage"
+	QueryTotalSupply     = "total-supply"
+)
+
+// maxIssuanceHistoryDays bounds the day range of a single issuance history query
+const maxIssuanceHistoryDays = 366
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryIssuance:
+			return queryIssuance(ctx, path[1:], req, keeper)
+		case QueryIssuanceHistory:
+			return queryIssuanceHistory(ctx, path[1:], req, keeper)
+		case QuerySeigniorage:
+			return querySeigniorage(ctx, path[1:], req, keeper)
+		case QueryTotalSupply:
+			return queryTotalSupply(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown mint query endpoint")
+		}
+	}
+}
+
+// parseDay reads a day number from the query path; an empty string stands for the current day
+func parseDay(ctx sdk.Context, keeper Keeper, dayStr string) (sdk.Int, sdk.Error) {
+	if len(dayStr) == 0 {
+		return keeper.ck.GetDay(ctx), nil
+	}
+
+	day, ok := sdk.NewIntFromString(dayStr)
+	if !ok || day.IsNegative() {
+		return sdk.Int{}, sdk.ErrUnknownRequest(fmt.Sprintf("day parameter {%s} is not correctly formatted", dayStr))
+	}
+
+	return day, nil
+}
+
+// JSON response format
+type QueryIssuanceResponse struct {
+	Denom    string  `json:"denom"`
+	Day      sdk.Int `json:"day"`
+	Issuance sdk.Int `json:"issuance"`
+}
+
+func (r QueryIssuanceResponse) String() (out string) {
+	out = fmt.Sprintf("Day %s: %s%s", r.Day, r.Issuance, r.Denom)
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryIssuance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || len(path[0]) == 0 {
+		return nil, sdk.ErrUnknownRequest("denom parameter is required")
+	}
+
+	denom := path[0]
+	var dayStr string
+	if len(path) > 1 {
+		dayStr = path[1]
+	}
+
+	day, err := parseDay(ctx, keeper, dayStr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = keeper.ValidateIssuanceDay(ctx, day); err != nil {
+		return nil, err
+	}
+
+	issuance := keeper.GetIssuance(ctx, denom, day)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, QueryIssuanceResponse{Denom: denom, Day: day, Issuance: issuance})
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QueryIssuanceHistoryResponse []QueryIssuanceResponse
+
+func (r QueryIssuanceHistoryResponse) String() (out string) {
+	for _, issuance := range r {
+		out += issuance.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryIssuanceHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) < 3 || len(path[0]) == 0 {
+		return nil, sdk.ErrUnknownRequest("denom, from day and to day parameters are required")
+	}
+
+	denom := path[0]
+	fromDay, err := parseDay(ctx, keeper, path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	toDay, err := parseDay(ctx, keeper, path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if toDay.LT(fromDay) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("from day %s should not be later than to day %s", fromDay, toDay))
+	}
+
+	if toDay.Sub(fromDay).GTE(sdk.NewInt(maxIssuanceHistoryDays)) {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("day range should be shorter than %d days", maxIssuanceHistoryDays))
+	}
+
+	if err = keeper.ValidateIssuanceDay(ctx, fromDay); err != nil {
+		return nil, err
+	}
+
+	history := QueryIssuanceHistoryResponse{}
+	for day := fromDay; day.LTE(toDay); day = day.Add(sdk.OneInt()) {
+		issuance := keeper.GetIssuance(ctx, denom, day)
+		history = append(history, QueryIssuanceResponse{Denom: denom, Day: day, Issuance: issuance})
+	}
+
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, history)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QuerySeigniorageResponse struct {
+	Epoch       sdk.Int `json:"epoch"`
+	Seigniorage sdk.Int `json:"seigniorage"`
+}
+
+func (r QuerySeigniorageResponse) String() (out string) {
+	out = r.Seigniorage.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func querySeigniorage(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	epoch := keeper.ck.GetEpoch(ctx)
+	if len(path) > 0 && len(path[0]) != 0 {
+		var ok bool
+		epoch, ok = sdk.NewIntFromString(path[0])
+		if !ok || epoch.IsNegative() {
+			return nil, sdk.ErrUnknownRequest("epoch parameter is not correctly formatted")
+		}
+	}
+
+	if err := keeper.ValidateEpochSeigniorage(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	seigniorage := keeper.PeekEpochSeigniorage(ctx, epoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QuerySeigniorageResponse{Epoch: epoch, Seigniorage: seigniorage})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// nolint: unparam
+func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTotalSupply(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,152 @@
+package mint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const custom = "custom"
+
+func TestQueryIssuance(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.mintKeeper)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryIssuance}, "/"),
+		Data: []byte{},
+	}
+
+	// Current day is used when the day is omitted
+	bz, err := querier(input.ctx, []string{QueryIssuance, assets.MicroSDRDenom}, query)
+	require.Nil(t, err)
+
+	var response QueryIssuanceResponse
+	input.mintKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, sdk.ZeroInt(), response.Day)
+	require.Equal(t, uSDRAmount.MulRaw(3), response.Issuance)
+
+	// Invalid day
+	_, err = querier(input.ctx, []string{QueryIssuance, assets.MicroSDRDenom, "abc"}, query)
+	require.NotNil(t, err)
+
+	// Missing denom
+	_, err = querier(input.ctx, []string{QueryIssuance}, query)
+	require.NotNil(t, err)
+}
+
+func TestQueryIssuanceHistory(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.mintKeeper)
+
+	// Mint on the second day
+	ctx := input.ctx.WithBlockHeight(util.BlocksPerDay)
+	increment := sdk.NewInt(10).MulRaw(assets.MicroUnit)
+	err := input.mintKeeper.Mint(ctx, addrs[0], sdk.NewCoin(assets.MicroSDRDenom, increment))
+	require.Nil(t, err)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryIssuanceHistory}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{QueryIssuanceHistory, assets.MicroSDRDenom, "0", "2"}, query)
+	require.Nil(t, err)
+
+	var response QueryIssuanceHistoryResponse
+	input.mintKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, 3, len(response))
+	require.Equal(t, uSDRAmount.MulRaw(3), response[0].Issuance)
+	require.Equal(t, uSDRAmount.MulRaw(3).Add(increment), response[1].Issuance)
+	require.Equal(t, uSDRAmount.MulRaw(3).Add(increment), response[2].Issuance)
+
+	// Reversed range
+	_, err = querier(ctx, []string{QueryIssuanceHistory, assets.MicroSDRDenom, "2", "0"}, query)
+	require.NotNil(t, err)
+
+	// Too long range
+	_, err = querier(ctx, []string{QueryIssuanceHistory, assets.MicroSDRDenom, "0", "1000"}, query)
+	require.NotNil(t, err)
+}
+
+func TestQuerySeigniorage(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.mintKeeper)
+
+	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(100)))
+	input.mintKeeper.Burn(input.ctx.WithBlockHeight(util.BlocksPerEpoch-1), addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(100)))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QuerySeigniorage}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(input.ctx.WithBlockHeight(util.BlocksPerEpoch), []string{QuerySeigniorage, "0"}, query)
+	require.Nil(t, err)
+
+	var response QuerySeigniorageResponse
+	input.mintKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, sdk.ZeroInt(), response.Epoch)
+	require.Equal(t, sdk.NewInt(100), response.Seigniorage)
+}
+
+func TestQueryTotalSupply(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.mintKeeper)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTotalSupply}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(input.ctx, []string{QueryTotalSupply}, query)
+	require.Nil(t, err)
+
+	var totalSupply sdk.Coins
+	input.mintKeeper.cdc.MustUnmarshalJSON(bz, &totalSupply)
+	require.Equal(t, uSDRAmount.MulRaw(3), totalSupply.AmountOf(assets.MicroSDRDenom))
+	require.Equal(t, sdk.ZeroInt(), totalSupply.AmountOf(assets.MicroLunaDenom))
+}
+
+func TestQueryPruned(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.mintKeeper)
+
+	ctx := input.ctx.WithBlockHeight(util.BlocksPerEpoch * 3)
+	input.mintKeeper.PruneIssuanceSnapshots(ctx, sdk.NewInt(10))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryIssuance}, "/"),
+		Data: []byte{},
+	}
+
+	_, err := querier(ctx, []string{QueryIssuance, assets.MicroSDRDenom, "9"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeIssuancePruned, err.Code())
+
+	bz, err := querier(ctx, []string{QueryIssuance, assets.MicroSDRDenom, "10"}, query)
+	require.Nil(t, err)
+
+	var response QueryIssuanceResponse
+	input.mintKeeper.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, uSDRAmount.MulRaw(3), response.Issuance)
+
+	_, err = querier(ctx, []string{QueryIssuanceHistory, assets.MicroSDRDenom, "9", "12"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeIssuancePruned, err.Code())
+
+	// Epoch 1 reads the issuance of day 6
+	_, err = querier(ctx, []string{QuerySeigniorage, "1"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeIssuancePruned, err.Code())
+
+	_, err = querier(ctx, []string{QuerySeigniorage, "2"}, query)
+	require.Nil(t, err)
+}
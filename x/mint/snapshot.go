@@ -0,0 +1,43 @@
+package mint
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IssuanceSnapshot - struct to store the issuance of a denom at the end of a day
+type IssuanceSnapshot struct {
+	Denom    string  `json:"denom"`    // Denom of the coin
+	Day      sdk.Int `json:"day"`      // Number of days after genesis time
+	Issuance sdk.Int `json:"issuance"` // Issuance of the coin at the end of the day
+}
+
+// NewIssuanceSnapshot creates an IssuanceSnapshot instance
+func NewIssuanceSnapshot(denom string, day sdk.Int, issuance sdk.Int) IssuanceSnapshot {
+	return IssuanceSnapshot{
+		Denom:    denom,
+		Day:      day,
+		Issuance: issuance,
+	}
+}
+
+// String implements fmt.Stringer
+func (s IssuanceSnapshot) String() string {
+	return fmt.Sprintf(`IssuanceSnapshot
+	Denom:    %s
+	Day:      %s
+	Issuance: %s`,
+		s.Denom, s.Day, s.Issuance)
+}
+
+// IssuanceSnapshots is a collection of IssuanceSnapshot
+type IssuanceSnapshots []IssuanceSnapshot
+
+func (s IssuanceSnapshots) String() (out string) {
+	for _, val := range s {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
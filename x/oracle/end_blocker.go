@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/util"
 
 	"sort"
@@ -54,15 +55,9 @@ func rewardPrevBallotWinners(ctx sdk.Context, k Keeper) {
 				k.fck.AddCollectedFees(ctx, leftFee)
 			}
 
-			// Change Issuerance
-			for _, feeCoin := range accmFeePool {
-
-				// never return err, but handle err for lint
-				err := k.mk.ChangeIssuance(ctx, feeCoin.Denom, feeCoin.Amount)
-				if err != nil {
-					panic(err)
-				}
-			}
+			// The fees were issued as they entered the pool; the luna among them is now held by the
+			// distribution module and the fee collector, which the staking pool tracks
+			k.mk.ChangeNotBondedTokens(ctx, accmFeePool.AmountOf(assets.MicroLunaDenom))
 
 			// Clear swap fee pool
 			k.clearSwapFeePool(ctx)
@@ -121,7 +116,7 @@ func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
 	// Reward previous ballot winners
 	rewardPrevBallotWinners(ctx, k)
 
-	actives := k.getActiveDenoms(ctx)
+	actives := k.GetActiveDenoms(ctx)
 	votes := k.collectVotes(ctx)
 
 	// Clear swap rates
@@ -14,5 +14,10 @@ type FeeCollectionKeeper interface {
 
 // expected mint keeper
 type MintKeeper interface {
-	ChangeIssuance(ctx sdk.Context, denom string, delta sdk.Int) (err sdk.Error)
+	ChangeNotBondedTokens(ctx sdk.Context, delta sdk.Int)
+}
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
 }
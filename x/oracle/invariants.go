@@ -0,0 +1,27 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all oracle invariants
+func RegisterInvariants(c CrisisKeeper, k Keeper) {
+	c.RegisterRoute(ModuleName, "claim-weights", ClaimWeightsInvariant(k))
+}
+
+// ClaimWeightsInvariant checks that no claim in the oracle claim pool has a negative weight
+func ClaimWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		k.iterateClaimPool(ctx, func(recipient sdk.AccAddress, weight sdk.Int) (stop bool) {
+			if weight.IsNegative() {
+				err = fmt.Errorf("negative claim weight %s for recipient %s", weight, recipient)
+				return true
+			}
+			return false
+		})
+
+		return
+	}
+}
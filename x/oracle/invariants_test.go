@@ -0,0 +1,22 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestClaimWeightsInvariant(t *testing.T) {
+	input := createTestInput(t)
+	invariant := ClaimWeightsInvariant(input.oracleKeeper)
+
+	input.oracleKeeper.addClaimPool(input.ctx, types.ClaimPool{types.NewClaim(sdk.NewInt(10), addrs[0])})
+	require.Nil(t, invariant(input.ctx))
+
+	input.oracleKeeper.addClaimPool(input.ctx, types.ClaimPool{types.NewClaim(sdk.NewInt(-20), addrs[0])})
+	require.NotNil(t, invariant(input.ctx))
+}
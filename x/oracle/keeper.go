@@ -195,8 +195,8 @@ func (k Keeper) deletePrice(ctx sdk.Context, denom string) {
 	store.Delete(keyPrice(denom))
 }
 
-// Get all active oracle asset denoms from the store
-func (k Keeper) getActiveDenoms(ctx sdk.Context) (denoms DenomList) {
+// GetActiveDenoms returns the denoms whitelisted by the oracle, i.e. the denoms with a swap rate in the store
+func (k Keeper) GetActiveDenoms(ctx sdk.Context) (denoms DenomList) {
 	denoms = DenomList{}
 
 	store := ctx.KVStore(k.key)
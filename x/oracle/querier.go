@@ -78,7 +78,7 @@ func (r QueryActiveResponse) String() (out string) {
 }
 
 func queryActive(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	denoms := keeper.getActiveDenoms(ctx)
+	denoms := keeper.GetActiveDenoms(ctx)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryActiveResponse{Actives: denoms})
 	if err != nil {
@@ -0,0 +1,84 @@
+package pay
+
+import (
+	"fmt"
+
+	"github.com/terra-project/core/x/treasury"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// NewAnteHandler returns an AnteHandler that charges the stability tax of a tx within its fee.
+// The auth AnteHandler first verifies the signatures and deducts the whole fee; the fee must then
+// cover the tax of every msg on top of the gas fee, or the tx is rejected and the deduction dropped
+// with the rest of the ante state. Taxes are computed under the gas meter of the tx and recorded as
+// tax proceeds of the current epoch, as the deducted fee is kept whether the msgs succeed or not.
+// Simulations skip the fee check, so that the required tax can be read from the msg logs.
+func NewAnteHandler(ak auth.AccountKeeper, fck auth.FeeCollectionKeeper, tk treasury.Keeper) sdk.AnteHandler {
+	authAnteHandler := auth.NewAnteHandler(ak, fck)
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		newCtx, res, abort = authAnteHandler(ctx, tx, simulate)
+		if abort {
+			return newCtx, res, abort
+		}
+
+		// auth rejects txs of other types
+		stdTx := tx.(auth.StdTx)
+		taxes := ComputeTaxes(newCtx, tk, stdTx.GetMsgs())
+		if simulate {
+			return newCtx, res, abort
+		}
+
+		if !stdTx.Fee.Amount.IsAllGTE(taxes) {
+			return newCtx, sdk.ErrInsufficientFee(fmt.Sprintf(
+				"insufficient fee to pay the stability tax; got: %q required: %q", stdTx.Fee.Amount, taxes)).Result(), true
+		}
+
+		// Validators only accept the tx to the mempool if the fee left after taxes pays for the gas
+		if newCtx.IsCheckTx() {
+			gasFee := auth.NewStdFee(stdTx.Fee.Gas, stdTx.Fee.Amount.Sub(taxes))
+			if res := auth.EnsureSufficientMempoolFees(newCtx, gasFee); !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+
+		if !taxes.IsZero() {
+			tk.RecordTaxProceeds(newCtx, taxes)
+		}
+
+		return newCtx, res, abort
+	}
+}
+
+// ComputeTaxes returns the stability tax due on all the msgs of a tx
+func ComputeTaxes(ctx sdk.Context, tk treasury.Keeper, msgs []sdk.Msg) (taxes sdk.Coins) {
+	for _, msg := range msgs {
+		taxes = taxes.Add(computeMsgTax(ctx, tk, msg))
+	}
+
+	return
+}
+
+// computeMsgTax returns the stability tax due on a msg. Only transfers to other accounts are
+// taxed; market swaps credit the trader and pay a spread instead.
+func computeMsgTax(ctx sdk.Context, tk treasury.Keeper, msg sdk.Msg) (taxes sdk.Coins) {
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		taxes = tk.ComputeTax(ctx, msg.FromAddress, []sdk.AccAddress{msg.ToAddress}, msg.Amount)
+
+	case bank.MsgMultiSend:
+		recipients := make([]sdk.AccAddress, len(msg.Outputs))
+		for i, output := range msg.Outputs {
+			recipients[i] = output.Address
+		}
+
+		for _, input := range msg.Inputs {
+			taxes = taxes.Add(tk.ComputeTax(ctx, input.Address, recipients, input.Coins))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,171 @@
+package pay
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/x/treasury"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// newTestTx signs {msgs} with the keys of the addresses at {signers}
+func newTestTx(t *testing.T, input testInput, msgs []sdk.Msg, signers []int, fee auth.StdFee) auth.StdTx {
+	sigs := make([]auth.StdSignature, len(signers))
+	for i, signer := range signers {
+		acc := input.accKeeper.GetAccount(input.ctx, addrs[signer])
+		signBytes := auth.StdSignBytes(input.ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), fee, msgs, "")
+
+		sig, err := privKeys[signer].Sign(signBytes)
+		require.NoError(t, err)
+
+		sigs[i] = auth.StdSignature{PubKey: privKeys[signer].PubKey(), Signature: sig}
+	}
+
+	return auth.NewStdTx(msgs, fee, sigs, "")
+}
+
+func uSDR(amount int64) sdk.Coins {
+	return sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(amount))}
+}
+
+func TestAnteHandlerMsgSendTax(t *testing.T) {
+	input := createTestInput(t)
+	params := treasury.DefaultParams()
+
+	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 3)) // 0.1%
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	anteHandler := NewAnteHandler(input.accKeeper, input.feeKeeper, input.treasuryKeeper)
+	amt := sdk.NewInt(1000).MulRaw(assets.MicroUnit)
+	msgs := []sdk.Msg{bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})}
+
+	// The fee does not cover the tax; as in the base app, the state of the aborted ante is dropped
+	tx := newTestTx(t, input, msgs, []int{0}, auth.NewStdFee(200000, uSDR(assets.MicroUnit/2)))
+	cacheCtx, _ := input.ctx.CacheContext()
+	_, res, abort := anteHandler(cacheCtx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInsufficientFee, res.Code)
+
+	// Unsigned txs are rejected before any tax is computed
+	tx = auth.NewStdTx(msgs, auth.NewStdFee(200000, uSDR(assets.MicroUnit)), nil, "")
+	_, res, abort = anteHandler(input.ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	require.True(t, input.treasuryKeeper.PeekTaxProceeds(input.ctx, input.calendarKeeper.GetEpoch(input.ctx)).IsZero())
+
+	// The tax is charged with the fee and recorded
+	tax := uSDR(assets.MicroUnit)
+	require.Equal(t, tax, ComputeTaxes(input.ctx, input.treasuryKeeper, msgs))
+
+	tx = newTestTx(t, input, msgs, []int{0}, auth.NewStdFee(200000, tax))
+	_, res, abort = anteHandler(input.ctx, tx, false)
+	require.False(t, abort, res.Log)
+
+	require.Equal(t, tax, input.feeKeeper.GetCollectedFees(input.ctx))
+	require.Equal(t, tax, input.treasuryKeeper.PeekTaxProceeds(input.ctx, input.calendarKeeper.GetEpoch(input.ctx)))
+	require.Equal(t, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, uSDRAmount.Sub(tax.AmountOf(assets.MicroSDRDenom)))},
+		input.bankKeeper.GetCoins(input.ctx, addrs[0]))
+
+	// The tax is capped
+	params.TaxPolicy.Cap = sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(2).MulRaw(assets.MicroUnit)) // 2 SDR cap
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	amt = sdk.NewInt(10000).MulRaw(assets.MicroUnit)
+	msgs = []sdk.Msg{bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})}
+	require.Equal(t, uSDR(2*assets.MicroUnit), ComputeTaxes(input.ctx, input.treasuryKeeper, msgs))
+}
+
+func TestAnteHandlerMsgMultiSendTax(t *testing.T) {
+	input := createTestInput(t)
+
+	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 2)) // 1%
+
+	params := treasury.DefaultParams()
+	params.TaxPolicy.Cap = sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(2).MulRaw(assets.MicroUnit)) // 2 SDR cap
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	anteHandler := NewAnteHandler(input.accKeeper, input.feeKeeper, input.treasuryKeeper)
+
+	msgs := []sdk.Msg{bank.NewMsgMultiSend(
+		[]bank.Input{
+			bank.NewInput(addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(398).MulRaw(assets.MicroUnit))}),
+			bank.NewInput(addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(14).MulRaw(assets.MicroUnit))}),
+			bank.NewInput(addrs[2], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(189).MulRaw(assets.MicroUnit))}),
+		},
+		[]bank.Output{
+			bank.NewOutput(addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(601).MulRaw(assets.MicroUnit))}),
+		},
+	)}
+
+	// 2 SDR (capped) + 0.14 SDR + 1.89 SDR
+	tax := sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewDecFromIntWithPrec(sdk.NewInt(403), 2).MulInt64(assets.MicroUnit).TruncateInt())}
+	require.Equal(t, tax, ComputeTaxes(input.ctx, input.treasuryKeeper, msgs))
+
+	tx := newTestTx(t, input, msgs, []int{0, 1, 2}, auth.NewStdFee(200000, tax))
+	_, res, abort := anteHandler(input.ctx, tx, false)
+	require.False(t, abort, res.Log)
+
+	require.Equal(t, tax, input.feeKeeper.GetCollectedFees(input.ctx))
+	require.Equal(t, tax, input.treasuryKeeper.PeekTaxProceeds(input.ctx, input.calendarKeeper.GetEpoch(input.ctx)))
+}
+
+func TestAnteHandlerTaxExemption(t *testing.T) {
+	input := createTestInput(t)
+
+	input.treasuryKeeper.SetParams(input.ctx, treasury.DefaultParams())
+	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 3)) // 0.1%
+
+	exemption := treasury.NewTaxExemption(1, "exchange", "hot and cold wallets",
+		[]sdk.AccAddress{addrs[0], addrs[1]}, addrs[0], 0, 0)
+	exemption.Active = true
+	input.treasuryKeeper.StoreTaxExemption(input.ctx, exemption)
+
+	amt := sdk.NewInt(1000).MulRaw(assets.MicroUnit)
+
+	// Transfers within the exempted set are not taxed
+	msgs := []sdk.Msg{bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})}
+	require.True(t, ComputeTaxes(input.ctx, input.treasuryKeeper, msgs).IsZero())
+
+	msgs = []sdk.Msg{bank.NewMsgMultiSend(
+		[]bank.Input{bank.NewInput(addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})},
+		[]bank.Output{bank.NewOutput(addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})},
+	)}
+	require.True(t, ComputeTaxes(input.ctx, input.treasuryKeeper, msgs).IsZero())
+
+	anteHandler := NewAnteHandler(input.accKeeper, input.feeKeeper, input.treasuryKeeper)
+	tx := newTestTx(t, input, msgs, []int{1}, auth.NewStdFee(200000, sdk.Coins{}))
+	_, res, abort := anteHandler(input.ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.True(t, input.treasuryKeeper.PeekTaxProceeds(input.ctx, input.calendarKeeper.GetEpoch(input.ctx)).IsZero())
+
+	// Transfers leaving the set are taxed
+	msgs = []sdk.Msg{bank.NewMsgSend(addrs[0], addrs[2], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})}
+	require.Equal(t, uSDR(assets.MicroUnit), ComputeTaxes(input.ctx, input.treasuryKeeper, msgs))
+}
+
+func TestAnteHandlerSimulate(t *testing.T) {
+	input := createTestInput(t)
+	input.bankKeeper.SetSendEnabled(input.ctx, true)
+
+	input.treasuryKeeper.SetParams(input.ctx, treasury.DefaultParams())
+	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 3)) // 0.1%
+
+	anteHandler := NewAnteHandler(input.accKeeper, input.feeKeeper, input.treasuryKeeper)
+	amt := sdk.NewInt(1000).MulRaw(assets.MicroUnit)
+	msg := bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
+
+	// Simulations run without the tax in the fee, and the handler log reports the tax due
+	tx := newTestTx(t, input, []sdk.Msg{msg}, []int{0}, auth.NewStdFee(200000, sdk.Coins{}))
+	newCtx, res, abort := anteHandler(input.ctx, tx, true)
+	require.False(t, abort, res.Log)
+	require.True(t, input.treasuryKeeper.PeekTaxProceeds(input.ctx, input.calendarKeeper.GetEpoch(input.ctx)).IsZero())
+
+	res = NewHandler(input.bankKeeper, input.treasuryKeeper)(newCtx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, NewLog().append(LogKeyTax, uSDR(assets.MicroUnit).String()).String(), res.Log)
+}
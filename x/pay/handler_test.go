@@ -1,12 +1,11 @@
 package pay
 
 import (
-	"fmt"
 	"testing"
 	"time"
 
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
@@ -31,10 +30,16 @@ import (
 )
 
 var (
+	privKeys = []crypto.PrivKey{
+		secp256k1.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+		secp256k1.GenPrivKey(),
+	}
+
 	addrs = []sdk.AccAddress{
-		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
-		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
-		sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(privKeys[0].PubKey().Address()),
+		sdk.AccAddress(privKeys[1].PubKey().Address()),
+		sdk.AccAddress(privKeys[2].PubKey().Address()),
 	}
 
 	valConsPubKeys = []crypto.PubKey{
@@ -58,6 +63,7 @@ type testInput struct {
 	bankKeeper     bank.Keeper
 	treasuryKeeper treasury.Keeper
 	feeKeeper      auth.FeeCollectionKeeper
+	calendarKeeper calendar.Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -77,6 +83,7 @@ func createTestInput(t *testing.T) testInput {
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyTreasury := sdk.NewKVStoreKey(treasury.StoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracle.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tKeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
@@ -95,6 +102,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeTransient, db)
@@ -112,6 +120,7 @@ func createTestInput(t *testing.T) testInput {
 		paramsKeeper.Subspace(auth.DefaultParamspace),
 		auth.ProtoBaseAccount,
 	)
+	accKeeper.SetParams(ctx, auth.DefaultParams())
 
 	bankKeeper := bank.NewBaseKeeper(
 		accKeeper,
@@ -139,12 +148,17 @@ func createTestInput(t *testing.T) testInput {
 	stakingKeeper.SetPool(ctx, staking.InitialPool())
 	stakingKeeper.SetParams(ctx, staking.DefaultParams())
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := mint.NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	oracleKeeper := oracle.NewKeeper(
@@ -157,8 +171,8 @@ func createTestInput(t *testing.T) testInput {
 		paramsKeeper.Subspace(oracle.DefaultParamspace),
 	)
 
-	marketKeeper := market.NewKeeper(cdc, keyMarket, oracleKeeper, mintKeeper,
-		paramsKeeper.Subspace(market.DefaultParamspace))
+	marketKeeper := market.NewKeeper(cdc, keyMarket, oracleKeeper, mintKeeper, bankKeeper, distrKeeper,
+		calendarKeeper, paramsKeeper.Subspace(market.DefaultParamspace))
 	marketKeeper.SetParams(ctx, market.DefaultParams())
 
 	treasuryKeeper := treasury.NewKeeper(
@@ -167,6 +181,9 @@ func createTestInput(t *testing.T) testInput {
 		stakingKeeper.GetValidatorSet(),
 		mintKeeper,
 		marketKeeper,
+		oracleKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(treasury.DefaultParamspace),
 	)
 
@@ -175,14 +192,16 @@ func createTestInput(t *testing.T) testInput {
 		require.NoError(t, err)
 	}
 
-	return testInput{ctx, accKeeper, bankKeeper, treasuryKeeper, feeCollectionKeeper}
+	mint.InitGenesis(ctx, mintKeeper, mint.DefaultGenesisState())
+
+	return testInput{ctx, accKeeper, bankKeeper, treasuryKeeper, feeCollectionKeeper, calendarKeeper}
 }
 
 func TestHandlerMsgSendTransfersDisabled(t *testing.T) {
 	input := createTestInput(t)
 	input.bankKeeper.SetSendEnabled(input.ctx, false)
 
-	handler := NewHandler(input.bankKeeper, input.treasuryKeeper, input.feeKeeper)
+	handler := NewHandler(input.bankKeeper, input.treasuryKeeper)
 	amt := sdk.NewInt(5)
 	msg := bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
 
@@ -204,7 +223,7 @@ func TestHandlerMsgSendTransfersEnabled(t *testing.T) {
 	input.treasuryKeeper.SetParams(input.ctx, params)
 	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.ZeroDec()) // 0.0%
 
-	handler := NewHandler(input.bankKeeper, input.treasuryKeeper, input.feeKeeper)
+	handler := NewHandler(input.bankKeeper, input.treasuryKeeper)
 	amt := sdk.NewInt(5).MulRaw(assets.MicroUnit)
 	msg := bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
 
@@ -220,105 +239,25 @@ func TestHandlerMsgSendTransfersEnabled(t *testing.T) {
 	require.Equal(t, to.GetCoins(), sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, balance)})
 }
 
-func TestHandlerMsgSendTax(t *testing.T) {
+func TestHandlerMsgSendTaxLog(t *testing.T) {
 	input := createTestInput(t)
 	input.bankKeeper.SetSendEnabled(input.ctx, true)
-	params := treasury.DefaultParams()
 
+	input.treasuryKeeper.SetParams(input.ctx, treasury.DefaultParams())
 	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 3)) // 0.1%
-	input.treasuryKeeper.SetParams(input.ctx, params)
 
-	handler := NewHandler(input.bankKeeper, input.treasuryKeeper, input.feeKeeper)
+	handler := NewHandler(input.bankKeeper, input.treasuryKeeper)
 	amt := sdk.NewInt(1000).MulRaw(assets.MicroUnit)
 	msg := bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
 
-	handler(input.ctx, msg)
-
-	taxCollected := input.feeKeeper.GetCollectedFees(input.ctx)
-	taxRecorded := input.treasuryKeeper.PeekTaxProceeds(input.ctx, util.GetEpoch(input.ctx))
-	require.Equal(t, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(1).MulRaw(assets.MicroUnit))}, taxCollected)
-	require.Equal(t, taxCollected, taxRecorded)
-
-	remainingBalance := input.bankKeeper.GetCoins(input.ctx, addrs[0])
-	require.Equal(t, remainingBalance, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(4).MulRaw(assets.MicroUnit))}, "expected 4 SDR to be remaining")
-
-	amt = sdk.NewInt(5).MulRaw(assets.MicroUnit)
-	msg = bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
 	res := handler(input.ctx, msg)
-	require.False(t, res.IsOK(), "expected failed message execution: %v", res.Log)
-
-	// Clear coin balances
-	err := input.bankKeeper.SetCoins(input.ctx, addrs[0], sdk.Coins{})
-	require.Nil(t, err)
-	err = input.bankKeeper.SetCoins(input.ctx, addrs[1], sdk.Coins{})
-	require.Nil(t, err)
-
-	// Give more coins
-	_, _, err = input.bankKeeper.AddCoins(input.ctx, addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(5000).MulRaw(assets.MicroUnit))})
-	require.Nil(t, err)
-
-	// Reset tax cap
-	params.TaxPolicy.Cap = sdk.NewInt64Coin(assets.MicroSDRDenom, sdk.NewInt(2).MulRaw(assets.MicroUnit).Int64()) // 2 SDR cap
-	input.treasuryKeeper.SetParams(input.ctx, params)
-	amt = sdk.NewInt(2000).MulRaw(assets.MicroUnit)
-	msg = bank.NewMsgSend(addrs[0], addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, amt)})
-	res = handler(input.ctx, msg)
 	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
 
-	remainingBalance = input.bankKeeper.GetCoins(input.ctx, addrs[0])
-	expectedRemainingBalance := sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(2999).MulRaw(assets.MicroUnit))}
-	receivedBalance := input.bankKeeper.GetCoins(input.ctx, addrs[1])
-	expectedReceivedBalance := sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(2000).MulRaw(assets.MicroUnit))}
-	taxCollected = input.feeKeeper.GetCollectedFees(input.ctx)
-	expectedTaxCollected := sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewDecFromIntWithPrec(sdk.NewInt(2005000), 6).MulInt64(assets.MicroUnit).TruncateInt())}
-
-	fmt.Println(taxCollected)
-	require.Equal(t, expectedRemainingBalance, remainingBalance)
-	require.Equal(t, expectedReceivedBalance, receivedBalance)
-	require.Equal(t, expectedTaxCollected, taxCollected)
-}
+	// The tax is reported, but charged by the AnteHandler rather than the handler
+	tax := sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(1).MulRaw(assets.MicroUnit))}
+	require.Equal(t, NewLog().append(LogKeyTax, tax.String()).String(), res.Log)
+	require.True(t, input.feeKeeper.GetCollectedFees(input.ctx).Empty())
 
-func TestHandlerMsgMultiSendTax(t *testing.T) {
-	input := createTestInput(t)
-	input.bankKeeper.SetSendEnabled(input.ctx, true)
-
-	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 2)) // 1%
-
-	params := treasury.DefaultParams()
-	params.TaxPolicy.Cap = sdk.NewInt64Coin(assets.MicroSDRDenom, sdk.NewInt(2).MulRaw(assets.MicroUnit).Int64()) // 2 SDR cap
-	input.treasuryKeeper.SetParams(input.ctx, params)
-
-	handler := NewHandler(input.bankKeeper, input.treasuryKeeper, input.feeKeeper)
-
-	msg := bank.NewMsgMultiSend(
-		[]bank.Input{
-			bank.NewInput(addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(398).MulRaw(assets.MicroUnit))}),
-			bank.NewInput(addrs[1], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(14).MulRaw(assets.MicroUnit))}),
-			bank.NewInput(addrs[2], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(189).MulRaw(assets.MicroUnit))}),
-		},
-		[]bank.Output{
-			bank.NewOutput(addrs[0], sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(601).MulRaw(assets.MicroUnit))}),
-		},
-	)
-
-	res := handler(input.ctx, msg)
-	require.True(t, res.IsOK(), "expected successful message execution: %v", res.Log)
-
-	taxCollected := input.feeKeeper.GetCollectedFees(input.ctx)
-	taxRecorded := input.treasuryKeeper.PeekTaxProceeds(input.ctx, util.GetEpoch(input.ctx))
-
-	require.Equal(t, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewDecFromIntWithPrec(sdk.NewInt(403), 2).MulInt64(assets.MicroUnit).TruncateInt())}, taxCollected)
-	require.Equal(t, taxCollected, taxRecorded)
-
-	acc1 := input.accKeeper.GetAccount(input.ctx, addrs[0])
-	balance := uSDRAmount.Add(sdk.NewInt(201).MulRaw(assets.MicroUnit))
-	require.Equal(t, acc1.GetCoins(), sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, balance)})
-
-	acc2 := input.accKeeper.GetAccount(input.ctx, addrs[1])
-	balance = uSDRAmount.Sub(sdk.NewDecFromIntWithPrec(sdk.NewInt(1414), 2).MulInt64(assets.MicroUnit).TruncateInt())
-	require.Equal(t, acc2.GetCoins(), sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, balance)})
-
-	acc3 := input.accKeeper.GetAccount(input.ctx, addrs[2])
-	balance = uSDRAmount.Sub(sdk.NewDecFromIntWithPrec(sdk.NewInt(19089), 2).MulInt64(assets.MicroUnit).TruncateInt())
-	require.Equal(t, acc3.GetCoins(), sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, balance)})
+	from := input.accKeeper.GetAccount(input.ctx, addrs[0])
+	require.Equal(t, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, uSDRAmount.Sub(amt))}, from.GetCoins())
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/terra-project/core/testutil"
 	"github.com/terra-project/core/x/treasury"
+
+	"github.com/cosmos/cosmos-sdk/client"
 )
 
 func TestQueryTaxRate(t *testing.T) {
@@ -24,6 +26,9 @@ func TestQueryTaxRate(t *testing.T) {
 	// Check Flags
 	epochFlag := queryTaxRate.Flag(flagEpoch)
 	require.NotNil(t, epochFlag)
+
+	denomFlag := queryTaxRate.Flag(flagDenom)
+	require.NotNil(t, denomFlag)
 }
 
 func TestQueryTaxCap(t *testing.T) {
@@ -41,6 +46,9 @@ func TestQueryTaxCap(t *testing.T) {
 	denomFlag := queryTaxCap.Flag(flagDenom)
 	require.NotNil(t, denomFlag)
 	require.Equal(t, []string{"true"}, denomFlag.Annotations[cobra.BashCompOneRequiredFlag])
+
+	epochFlag := queryTaxCap.Flag(flagEpoch)
+	require.NotNil(t, epochFlag)
 }
 
 func TestQueryIssuance(t *testing.T) {
@@ -111,6 +119,22 @@ func TestQuerySeigniorageProceeds(t *testing.T) {
 	require.NotNil(t, epochFlag)
 }
 
+func TestQuerySeigniorageAllocation(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	querySeigniorageAllocation := GetCmdQuerySeigniorageAllocation(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QuerySeigniorageAllocation, querySeigniorageAllocation.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(querySeigniorageAllocation.Args))
+
+	// Check Flags
+	epochFlag := querySeigniorageAllocation.Flag(flagEpoch)
+	require.NotNil(t, epochFlag)
+}
+
 func TestQueryCurrentEpoch(t *testing.T) {
 	cdc, _, _, _ := testutil.PrepareCmdTest()
 
@@ -134,3 +158,178 @@ func TestQueryParams(t *testing.T) {
 	// NoArg check
 	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryParams.Args))
 }
+
+func TestQueryTaxExemptions(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryTaxExemptions := GetCmdQueryTaxExemptions(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryTaxExemptions, queryTaxExemptions.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryTaxExemptions.Args))
+}
+
+func TestQueryTaxExemption(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryTaxExemption := GetCmdQueryTaxExemption(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryTaxExemption, queryTaxExemption.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryTaxExemption.Args))
+
+	// Check Flags
+	exemptionIDFlag := queryTaxExemption.Flag(flagExemptionID)
+	require.NotNil(t, exemptionIDFlag)
+	require.Equal(t, []string{"true"}, exemptionIDFlag.Annotations[cobra.BashCompOneRequiredFlag])
+}
+
+func TestQueryTaxEstimate(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryTaxEstimate := GetCmdQueryTaxEstimate(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryTaxEstimate, queryTaxEstimate.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryTaxEstimate.Args))
+
+	// Check Flags
+	principalFlag := queryTaxEstimate.Flag(flagPrincipal)
+	require.NotNil(t, principalFlag)
+	require.Equal(t, []string{"true"}, principalFlag.Annotations[cobra.BashCompOneRequiredFlag])
+}
+
+func TestQueryIndicator(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryIndicator := GetCmdQueryIndicator(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryIndicator, queryIndicator.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryIndicator.Args))
+
+	// Check Flags
+	indicatorFlag := queryIndicator.Flag(flagIndicator)
+	require.NotNil(t, indicatorFlag)
+	require.Equal(t, []string{"true"}, indicatorFlag.Annotations[cobra.BashCompOneRequiredFlag])
+
+	epochFlag := queryIndicator.Flag(flagEpoch)
+	require.NotNil(t, epochFlag)
+}
+
+func TestQueryIndicatorSeries(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryIndicatorSeries := GetCmdQueryIndicatorSeries(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryIndicatorSeries, queryIndicatorSeries.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryIndicatorSeries.Args))
+
+	// Check Flags
+	for _, flag := range []string{flagIndicator, flagStartEpoch, flagEndEpoch} {
+		f := queryIndicatorSeries.Flag(flag)
+		require.NotNil(t, f)
+		require.Equal(t, []string{"true"}, f.Annotations[cobra.BashCompOneRequiredFlag])
+	}
+}
+
+func TestQueryNextPolicy(t *testing.T) {
+	cdc, _, _, _ := testutil.PrepareCmdTest()
+
+	queryNextPolicy := GetCmdQueryNextPolicy(cdc)
+
+	// Name check
+	require.Equal(t, treasury.QueryNextPolicy, queryNextPolicy.Name())
+
+	// NoArg check
+	require.Equal(t, testutil.FS(cobra.PositionalArgs(cobra.NoArgs)), testutil.FS(queryNextPolicy.Args))
+}
+
+func TestSubmitTaxExemptionTx(t *testing.T) {
+	cdc, rootCmd, txCmd, _ := testutil.PrepareCmdTest()
+
+	treasuryTxCmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Treasury transaction subcommands",
+	}
+
+	txCmd.AddCommand(treasuryTxCmd)
+
+	treasuryTxCmd.AddCommand(client.PostCommands(
+		GetCmdSubmitTaxExemption(cdc),
+	)...)
+
+	// normal case all parameter given
+	_, err := testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`treasury`,
+		`submit-tax-exemption`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--title=exchange`,
+		`--description=exchangewallets`,
+		`--addresses=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv,terra1nk5lsuvy0rcfjcdr8au8za0wq25rat0qa07p6t`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+}
+
+func TestVoteTaxExemptionTx(t *testing.T) {
+	cdc, rootCmd, txCmd, _ := testutil.PrepareCmdTest()
+
+	treasuryTxCmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Treasury transaction subcommands",
+	}
+
+	txCmd.AddCommand(treasuryTxCmd)
+
+	treasuryTxCmd.AddCommand(client.PostCommands(
+		GetCmdVoteTaxExemption(cdc),
+		GetCmdWithdrawTaxExemption(cdc),
+	)...)
+
+	// normal case all parameter given
+	_, err := testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`treasury`,
+		`vote-tax-exemption`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--exemption-id=1`,
+		`--option=yes`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+
+	_, err = testutil.ExecuteCommand(
+		rootCmd,
+		`tx`,
+		`treasury`,
+		`withdraw-tax-exemption`,
+		`--from=terra1wg2mlrxdmnnkkykgqg4znky86nyrtc45q336yv`,
+		`--exemption-id=1`,
+		`--generate-only`,
+		`--offline`,
+		`--chain-id=columbus`,
+	)
+
+	require.Nil(t, err)
+}
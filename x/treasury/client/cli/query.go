@@ -19,6 +19,14 @@ const (
 	flagDenom = "denom"
 	flagDay   = "day"
 	flagEpoch = "epoch"
+
+	flagPrincipal = "principal"
+
+	flagIndicator  = "indicator"
+	flagStartEpoch = "start-epoch"
+	flagEndEpoch   = "end-epoch"
+
+	flagExemptionID = "exemption-id"
 )
 
 // GetCmdQueryTaxRate implements the query taxrate command.
@@ -28,9 +36,10 @@ func GetCmdQueryTaxRate(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.NoArgs,
 		Short: "Query the stability tax rate",
 		Long: strings.TrimSpace(`
-Query the stability tax rate at the specified epoch.
+Query the stability tax rate at the specified epoch. If a denom is given, query the tax rate charged on it;
+denoms without a tax rate of their own pay the single tax rate.
 
-$ terracli query treasury tax-rate --epoch=14
+$ terracli query treasury tax-rate --epoch=14 --denom="ukrw"
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -55,7 +64,12 @@ $ terracli query treasury tax-rate --epoch=14
 				}
 			}
 
-			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate, epoch.String()), nil)
+			route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate, epoch.String())
+			if denom := viper.GetString(flagDenom); len(denom) != 0 {
+				route = fmt.Sprintf("%s/%s", route, denom)
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				return err
 			}
@@ -67,6 +81,7 @@ $ terracli query treasury tax-rate --epoch=14
 	}
 
 	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you wants to get tax rate of; default is current epoch")
+	cmd.Flags().String(flagDenom, "", "(optional) the denom for which you want to know the tax rate of")
 	return cmd
 }
 
@@ -75,19 +90,30 @@ func GetCmdQueryTaxCap(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   treasury.QueryTaxCap,
 		Args:  cobra.NoArgs,
-		Short: "Query the current stability tax cap of a denom asset",
+		Short: "Query the stability tax cap of a denom asset",
 		Long: strings.TrimSpace(`
-Query the current stability tax cap of the denom asset. 
+Query the stability tax cap of the denom asset at the specified epoch. 
 The stability tax levied on a tx is at most tax cap, regardless of the size of the transaction. 
+Tax caps are recomputed from the oracle prices at the end of every epoch.
 
-$ terracli query treasury tax-cap --denom="ukrw"
+$ terracli query treasury tax-cap --denom="ukrw" --epoch=14
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			denom := viper.GetString(flagDenom)
 
-			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxCap, denom), nil)
+			route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxCap, denom)
+			if epochStr := viper.GetString(flagEpoch); len(epochStr) != 0 {
+				epoch, ok := sdk.NewIntFromString(epochStr)
+				if !ok {
+					return fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				}
+
+				route = fmt.Sprintf("%s/%s", route, epoch)
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				return err
 			}
@@ -99,6 +125,7 @@ $ terracli query treasury tax-cap --denom="ukrw"
 	}
 
 	cmd.Flags().String(flagDenom, "", "the denom for which you want to know the taxcap of")
+	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you wants to get tax cap of; default is current epoch")
 
 	cmd.MarkFlagRequired(flagDenom)
 
@@ -298,6 +325,58 @@ $ terracli query treasury seigniorage-proceeds --epoch=14
 	return cmd
 }
 
+// GetCmdQuerySeigniorageAllocation implements the query seigniorage-allocation command.
+func GetCmdQuerySeigniorageAllocation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QuerySeigniorageAllocation,
+		Args:  cobra.NoArgs,
+		Short: "Query where the seigniorage of the epoch was allocated",
+		Long: strings.TrimSpace(`
+Query the allocation of the seigniorage of the given epoch between mining rewards, oracle rewards,
+budget programs, the community pool and the burn. Amounts are in units of 'uluna' coins; the seigniorage
+of an epoch is allocated at its last block.
+
+$ terracli query treasury seigniorage-allocation --epoch=14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var epoch sdk.Int
+			epochStr := viper.GetString(flagEpoch)
+			if len(epochStr) == 0 {
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryCurrentEpoch), nil)
+				if err != nil {
+					return err
+				}
+
+				var epochResponse treasury.QueryCurrentEpochResponse
+				cdc.MustUnmarshalJSON(res, &epochResponse)
+
+				epoch = epochResponse.CurrentEpoch
+			} else {
+				var ok bool
+				epoch, ok = sdk.NewIntFromString(epochStr)
+				if !ok {
+					return fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				}
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QuerySeigniorageAllocation, epoch.String()), nil)
+			if err != nil {
+				return err
+			}
+
+			var allocation treasury.SeigniorageAllocation
+			cdc.MustUnmarshalJSON(res, &allocation)
+			return cliCtx.PrintOutput(allocation)
+		},
+	}
+
+	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you wants to get the seigniorage allocation of; default is current epoch")
+
+	return cmd
+}
+
 // GetCmdQueryCurrentEpoch implements the query seigniorage-proceeds command.
 func GetCmdQueryCurrentEpoch(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -348,3 +427,238 @@ func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryTaxExemptions implements the query tax-exemptions command.
+func GetCmdQueryTaxExemptions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryTaxExemptions,
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax exemptions",
+		Long: strings.TrimSpace(`
+Query every active and pending stability tax exemption.
+
+$ terracli query treasury tax-exemptions
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxExemptions), nil)
+			if err != nil {
+				return err
+			}
+
+			var exemptions treasury.QueryTaxExemptionsResponse
+			cdc.MustUnmarshalJSON(res, &exemptions)
+			return cliCtx.PrintOutput(exemptions)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTaxExemption implements the query tax-exemption command.
+func GetCmdQueryTaxExemption(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryTaxExemption,
+		Args:  cobra.NoArgs,
+		Short: "Query a stability tax exemption and its votes",
+		Long: strings.TrimSpace(`
+Query the stability tax exemption with the given id, along with the votes cast on it.
+
+$ terracli query treasury tax-exemption --exemption-id=1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			exemptionIDStr := viper.GetString(flagExemptionID)
+			if _, err := strconv.ParseUint(exemptionIDStr, 10, 64); err != nil {
+				return fmt.Errorf("given exemption-id {%s} is not a valid format; exemption-id should be formatted as integer", exemptionIDStr)
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxExemption, exemptionIDStr), nil)
+			if err != nil {
+				return err
+			}
+
+			var exemption treasury.QueryTaxExemptionResponse
+			cdc.MustUnmarshalJSON(res, &exemption)
+			return cliCtx.PrintOutput(exemption)
+		},
+	}
+
+	cmd.Flags().String(flagExemptionID, "", "the tax exemption ID to query")
+
+	cmd.MarkFlagRequired(flagExemptionID)
+
+	return cmd
+}
+
+// GetCmdQueryTaxEstimate implements the query tax-estimate command.
+func GetCmdQueryTaxEstimate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryTaxEstimate,
+		Args:  cobra.NoArgs,
+		Short: "Query the stability tax due on a transfer",
+		Long: strings.TrimSpace(`
+Query the stability tax due on a transfer of the given coins under the tax rate and tax caps of the current epoch.
+The tax of each denom is min(principal * tax rate, tax cap).
+
+$ terracli query treasury tax-estimate --principal="1000000ukrw,5000000usdr"
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			principal, err := sdk.ParseCoins(viper.GetString(flagPrincipal))
+			if err != nil {
+				return err
+			}
+
+			params := treasury.NewQueryTaxEstimateParams(principal)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxEstimate), bz)
+			if err != nil {
+				return err
+			}
+
+			var taxEstimate treasury.QueryTaxEstimateResponse
+			cdc.MustUnmarshalJSON(res, &taxEstimate)
+			return cliCtx.PrintOutput(taxEstimate)
+		},
+	}
+
+	cmd.Flags().String(flagPrincipal, "", "the coins to be transferred, e.g. 1000000ukrw,5000000usdr")
+
+	cmd.MarkFlagRequired(flagPrincipal)
+
+	return cmd
+}
+
+// GetCmdQueryIndicator implements the query indicator command.
+func GetCmdQueryIndicator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryIndicator,
+		Args:  cobra.NoArgs,
+		Short: "Query a treasury indicator for the epoch",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Query a treasury indicator at the given epoch, with its rolling averages and sums over the WindowShort and
+WindowLong epochs ending at that epoch. The supported indicators are %s (tax rewards / luna), %s (seigniorage
+rewards / luna), %s (mining rewards / luna), %s and %s, all denominated in micro SDR per epoch.
+
+$ terracli query treasury indicator --indicator=trl --epoch=14
+`, treasury.IndicatorTRL, treasury.IndicatorSRL, treasury.IndicatorMRL,
+			treasury.IndicatorSeigniorageRewards, treasury.IndicatorMiningRewards)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			indicator := viper.GetString(flagIndicator)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryIndicator, indicator)
+			if epochStr := viper.GetString(flagEpoch); len(epochStr) != 0 {
+				epoch, ok := sdk.NewIntFromString(epochStr)
+				if !ok {
+					return fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				}
+
+				route = fmt.Sprintf("%s/%s", route, epoch)
+			}
+
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var response treasury.QueryIndicatorResponse
+			cdc.MustUnmarshalJSON(res, &response)
+			return cliCtx.PrintOutput(response)
+		},
+	}
+
+	cmd.Flags().String(flagIndicator, "", "the indicator to query; one of trl, srl, mrl, seigniorage-rewards and mining-rewards")
+	cmd.Flags().String(flagEpoch, "", "(optional) an epoch number which you wants to get the indicator of; default is current epoch")
+
+	cmd.MarkFlagRequired(flagIndicator)
+
+	return cmd
+}
+
+// GetCmdQueryIndicatorSeries implements the query indicator-series command.
+func GetCmdQueryIndicatorSeries(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryIndicatorSeries,
+		Args:  cobra.NoArgs,
+		Short: "Query a treasury indicator for a range of epochs",
+		Long: strings.TrimSpace(`
+Query a treasury indicator and its rolling averages and sums for every epoch from the start epoch to the end epoch, inclusive.
+
+$ terracli query treasury indicator-series --indicator=mrl --start-epoch=10 --end-epoch=14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			indicator := viper.GetString(flagIndicator)
+
+			var epochs []sdk.Int
+			for _, epochStr := range []string{viper.GetString(flagStartEpoch), viper.GetString(flagEndEpoch)} {
+				epoch, ok := sdk.NewIntFromString(epochStr)
+				if !ok {
+					return fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				}
+
+				epochs = append(epochs, epoch)
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s", treasury.QuerierRoute, treasury.QueryIndicatorSeries, indicator, epochs[0], epochs[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			var response treasury.QueryIndicatorSeriesResponse
+			cdc.MustUnmarshalJSON(res, &response)
+			return cliCtx.PrintOutput(response)
+		},
+	}
+
+	cmd.Flags().String(flagIndicator, "", "the indicator to query; one of trl, srl, mrl, seigniorage-rewards and mining-rewards")
+	cmd.Flags().String(flagStartEpoch, "", "the first epoch of the series")
+	cmd.Flags().String(flagEndEpoch, "", "the last epoch of the series")
+
+	cmd.MarkFlagRequired(flagIndicator)
+	cmd.MarkFlagRequired(flagStartEpoch)
+	cmd.MarkFlagRequired(flagEndEpoch)
+
+	return cmd
+}
+
+// GetCmdQueryNextPolicy implements the query next-policy command.
+func GetCmdQueryNextPolicy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   treasury.QueryNextPolicy,
+		Args:  cobra.NoArgs,
+		Short: "Query the projected tax rate and reward weight of the next epoch",
+		Long: strings.TrimSpace(`
+Query the tax rate and reward weight the treasury would set for the next epoch if the current epoch ended now,
+along with the tax rates of the denoms listed in the tax_rate_denoms param. The policy updates are run against
+a cached state; the inputs and the values before the policy constraints are returned along with the projected rates.
+
+$ terracli query treasury next-policy
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryNextPolicy), nil)
+			if err != nil {
+				return err
+			}
+
+			var nextPolicy treasury.QueryNextPolicyResponse
+			cdc.MustUnmarshalJSON(res, &nextPolicy)
+			return cliCtx.PrintOutput(nextPolicy)
+		},
+	}
+
+	return cmd
+}
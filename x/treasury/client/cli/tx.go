@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/terra-project/core/x/treasury"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagTitle       = "title"
+	flagDescription = "description"
+	flagAddresses   = "addresses"
+	flagOption      = "option"
+	flagOffline     = "offline"
+)
+
+// GetCmdSubmitTaxExemption implements submitting a tax exemption transaction command.
+func GetCmdSubmitTaxExemption(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tax-exemption",
+		Args:  cobra.NoArgs,
+		Short: "Submit a set of addresses to be exempted from the stability tax among each other",
+		Long: strings.TrimSpace(`
+Submit a set of addresses to be exempted from the stability tax along with a deposit. Transfers are exempted
+when the sender and every recipient belong to the set, once validators have voted the exemption in.
+
+$ terracli tx treasury submit-tax-exemption --title="Exchange wallets" --description="Hot and cold wallets of an exchange" --addresses="terra1...,terra1..." --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get from address
+			from := cliCtx.GetFromAddress()
+
+			var addresses []sdk.AccAddress
+			for _, addrStr := range strings.Split(viper.GetString(flagAddresses), ",") {
+				addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(addrStr))
+				if err != nil {
+					return err
+				}
+
+				addresses = append(addresses, addr)
+			}
+
+			offline := viper.GetBool(flagOffline)
+			if !offline {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+
+				// Pull associated account
+				submitter, err := cliCtx.GetAccount(from)
+				if err != nil {
+					return err
+				}
+
+				submitterCoins := submitter.GetCoins()
+
+				// Query params to get deposit amount
+				res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryParams), nil)
+				if err != nil {
+					return err
+				}
+
+				var params treasury.Params
+				cdc.MustUnmarshalJSON(res, &params)
+
+				// Check submitter has enough coins to pay a deposit
+				if submitterCoins.AmountOf(params.ExemptionDeposit.Denom).LT(params.ExemptionDeposit.Amount) {
+					return fmt.Errorf("account %s has insufficient amount of coins to pay a deposit; required %s, given %s",
+						from, params.ExemptionDeposit.String(), submitterCoins.String())
+				}
+			}
+
+			msg := treasury.NewMsgSubmitTaxExemption(viper.GetString(flagTitle), viper.GetString(flagDescription), addresses, from)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "title of the tax exemption")
+	cmd.Flags().String(flagDescription, "", "description of the tax exemption")
+	cmd.Flags().String(flagAddresses, "", "comma separated list of the exempted addresses")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	cmd.MarkFlagRequired(flagTitle)
+	cmd.MarkFlagRequired(flagDescription)
+	cmd.MarkFlagRequired(flagAddresses)
+
+	return cmd
+}
+
+// GetCmdVoteTaxExemption implements creating a new tax exemption vote command.
+func GetCmdVoteTaxExemption(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-tax-exemption",
+		Args:  cobra.NoArgs,
+		Short: "Vote for a pending or active tax exemption, options: yes or no",
+		Long: strings.TrimSpace(`
+Submit a validator vote for a pending or active tax exemption.
+
+You can find the exemption-id of the exemptions by running terracli query treasury tax-exemptions
+
+$ terracli tx treasury vote-tax-exemption --exemption-id=1 --option=yes --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			exemptionIDStr := viper.GetString(flagExemptionID)
+			exemptionID, err := strconv.ParseUint(exemptionIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("given exemption-id {%s} is not a valid format; exemption-id should be formatted as integer", exemptionIDStr)
+			}
+
+			// Find out which vote option user chose
+			var option bool
+			optionStr := viper.GetString(flagOption)
+			if optionStr == "yes" || optionStr == "true" {
+				option = true
+			} else if optionStr == "no" || optionStr == "false" {
+				option = false
+			} else {
+				return fmt.Errorf(`given option {%s} is not valid format; option should be formatted as "yes" or "no"`, optionStr)
+			}
+
+			offline := viper.GetBool(flagOffline)
+			if !offline {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+			}
+
+			msg := treasury.NewMsgVoteTaxExemption(exemptionID, option, from)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.Flags().String(flagExemptionID, "", "the tax exemption ID to vote")
+	cmd.Flags().String(flagOption, "", "yes or no")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	cmd.MarkFlagRequired(flagExemptionID)
+	cmd.MarkFlagRequired(flagOption)
+
+	return cmd
+}
+
+// GetCmdWithdrawTaxExemption implements withdrawing a tax exemption command.
+func GetCmdWithdrawTaxExemption(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tax-exemption",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw a tax exemption",
+		Long: strings.TrimSpace(`
+Withdraw a tax exemption. The deposit is only refunded if the exemption has not passed a tally yet.
+
+$ terracli tx treasury withdraw-tax-exemption --exemption-id=1 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			// Get submitter address
+			from := cliCtx.GetFromAddress()
+
+			exemptionIDStr := viper.GetString(flagExemptionID)
+			exemptionID, err := strconv.ParseUint(exemptionIDStr, 10, 64)
+			if err != nil {
+				return fmt.Errorf("given exemption-id {%s} is not a valid format; exemption-id should be formatted as integer", exemptionIDStr)
+			}
+
+			offline := viper.GetBool(flagOffline)
+			if !offline {
+				if err := cliCtx.EnsureAccountExists(); err != nil {
+					return err
+				}
+			}
+
+			msg := treasury.NewMsgWithdrawTaxExemption(exemptionID, from)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}, offline)
+		},
+	}
+
+	cmd.Flags().String(flagExemptionID, "", "the tax exemption ID to withdraw")
+	cmd.Flags().Bool(flagOffline, false, " Offline mode; Without full node connection it can build and sign tx")
+
+	cmd.MarkFlagRequired(flagExemptionID)
+
+	return cmd
+}
@@ -34,14 +34,32 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		treasuryCli.GetCmdQueryIssuance(mc.cdc),
 		treasuryCli.GetCmdQueryTaxProceeds(mc.cdc),
 		treasuryCli.GetCmdQuerySeigniorageProceeds(mc.cdc),
+		treasuryCli.GetCmdQuerySeigniorageAllocation(mc.cdc),
 		treasuryCli.GetCmdQueryCurrentEpoch(mc.cdc),
 		treasuryCli.GetCmdQueryParams(mc.cdc),
+		treasuryCli.GetCmdQueryTaxExemptions(mc.cdc),
+		treasuryCli.GetCmdQueryTaxExemption(mc.cdc),
+		treasuryCli.GetCmdQueryTaxEstimate(mc.cdc),
+		treasuryCli.GetCmdQueryIndicator(mc.cdc),
+		treasuryCli.GetCmdQueryIndicatorSeries(mc.cdc),
+		treasuryCli.GetCmdQueryNextPolicy(mc.cdc),
 	)...)
 
 	return treasuryQueryCmd
 }
 
-// GetTxCmd The treasury module returns no TX commands.
+// GetTxCmd returns the transaction commands for this module
 func (mc ModuleClient) GetTxCmd() *cobra.Command {
-	return &cobra.Command{Hidden: true}
+	treasuryTxCmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Treasury transaction subcommands",
+	}
+
+	treasuryTxCmd.AddCommand(client.PostCommands(
+		treasuryCli.GetCmdSubmitTaxExemption(mc.cdc),
+		treasuryCli.GetCmdVoteTaxExemption(mc.cdc),
+		treasuryCli.GetCmdWithdrawTaxExemption(mc.cdc),
+	)...)
+
+	return treasuryTxCmd
 }
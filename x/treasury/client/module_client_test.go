@@ -14,14 +14,27 @@ const (
 
 var (
 	queryCmdList = map[string]bool{
-		"params":               true,
-		"tax-rate":             true,
-		"tax-cap":              true,
-		"reward-weight":        true,
-		"seigniorage-proceeds": true,
-		"current-epoch":        true,
-		"issuance":             true,
-		"tax-proceeds":         true,
+		"params":                 true,
+		"tax-rate":               true,
+		"tax-cap":                true,
+		"reward-weight":          true,
+		"seigniorage-proceeds":   true,
+		"seigniorage-allocation": true,
+		"current-epoch":          true,
+		"issuance":               true,
+		"tax-proceeds":           true,
+		"tax-exemptions":         true,
+		"tax-exemption":          true,
+		"tax-estimate":           true,
+		"indicator":              true,
+		"indicator-series":       true,
+		"next-policy":            true,
+	}
+
+	txCmdList = map[string]bool{
+		"submit-tax-exemption":   true,
+		"vote-tax-exemption":     true,
+		"withdraw-tax-exemption": true,
 	}
 )
 
@@ -37,3 +50,16 @@ func TestQueryCmdInvariant(t *testing.T) {
 
 	require.Equal(t, len(queryCmdList), len(mc.GetQueryCmd().Commands()))
 }
+
+func TestTxCmdInvariant(t *testing.T) {
+
+	cdc := app.MakeCodec()
+	mc := NewModuleClient(storeKey, cdc)
+
+	for _, cmd := range mc.GetTxCmd().Commands() {
+		_, ok := txCmdList[cmd.Name()]
+		require.True(t, ok)
+	}
+
+	require.Equal(t, len(txCmdList), len(mc.GetTxCmd().Commands()))
+}
@@ -19,6 +19,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryTaxRate), queryTaxRateHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryTaxRate, RestEpoch), queryTaxRateHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryTaxCap, RestDenom), queryTaxCapHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}/{%s}", treasury.QueryTaxCap, RestDenom, RestEpoch), queryTaxCapHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryMiningRewardWeight), queryMiningWeightHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryMiningRewardWeight, RestDenom), queryMiningWeightHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryIssuance, RestDenom), queryIssuanceHandlerFunction(cdc, cliCtx)).Methods("GET")
@@ -27,6 +28,19 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryTaxProceeds, RestEpoch), queryTaxProceedsHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QuerySeigniorageProceeds), querySgProceedsHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QuerySeigniorageProceeds, RestEpoch), querySgProceedsHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QuerySeigniorageAllocation), querySgAllocationHandlerFunction(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QuerySeigniorageAllocation, RestEpoch), querySgAllocationHandlerFunction(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryTaxExemptions), queryTaxExemptionsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryTaxExemptions, RestExemptionID), queryTaxExemptionHandlerFn(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}", treasury.QueryIndicator, RestIndicator), queryIndicatorHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}/{%s}", treasury.QueryIndicator, RestIndicator, RestEpoch), queryIndicatorHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}/{%s}/{%s}", treasury.QueryIndicatorSeries, RestIndicator, RestStartEpoch, RestEndEpoch), queryIndicatorSeriesHandlerFn(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc("/treasury/tax_estimate", queryTaxEstimateHandlerFn(cdc, cliCtx)).Methods("GET")
+
+	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryNextPolicy), queryNextPolicyHandlerFn(cdc, cliCtx)).Methods("GET")
 
 	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryCurrentEpoch), queryCurrentEpochHandlerFunction(cdc, cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/treasury/%s", treasury.QueryParams), queryParamsHandlerFn(cdc, cliCtx)).Methods("GET")
@@ -59,7 +73,12 @@ func queryTaxRateHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) ht
 			}
 		}
 
-		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate, epoch), nil)
+		route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxRate, epoch)
+		if denom := r.URL.Query().Get(RestDenom); len(denom) != 0 {
+			route = fmt.Sprintf("%s/%s", route, denom)
+		}
+
+		res, err := cliCtx.QueryWithData(route, nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -74,7 +93,19 @@ func queryTaxCapHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) htt
 		vars := mux.Vars(r)
 		denom := vars[RestDenom]
 
-		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxCap, denom), nil)
+		route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryTaxCap, denom)
+		if epochStr := vars[RestEpoch]; len(epochStr) != 0 {
+			epoch, ok := sdk.NewIntFromString(epochStr)
+			if !ok {
+				err := fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			route = fmt.Sprintf("%s/%s", route, epoch)
+		}
+
+		res, err := cliCtx.QueryWithData(route, nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -219,6 +250,43 @@ func querySgProceedsHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext)
 	}
 }
 
+func querySgAllocationHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		epochStr := vars[RestEpoch]
+
+		var epoch sdk.Int
+		if len(epochStr) == 0 {
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryCurrentEpoch), nil)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			var epochResponse treasury.QueryCurrentEpochResponse
+			cdc.MustUnmarshalJSON(res, &epochResponse)
+
+			epoch = epochResponse.CurrentEpoch
+		} else {
+			var ok bool
+			epoch, ok = sdk.NewIntFromString(epochStr)
+			if !ok {
+				err := fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QuerySeigniorageAllocation, epoch), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func queryCurrentEpochHandlerFunction(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -244,3 +312,128 @@ func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+func queryTaxExemptionsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxExemptions), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTaxExemptionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		exemptionID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestExemptionID])
+		if !ok {
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%d", treasury.QuerierRoute, treasury.QueryTaxExemption, exemptionID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryTaxEstimateHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		principalStr := r.URL.Query().Get(RestPrincipal)
+
+		principal, err := sdk.ParseCoins(principalStr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := treasury.NewQueryTaxEstimateParams(principal)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryTaxEstimate), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryIndicatorHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		indicator := vars[RestIndicator]
+
+		route := fmt.Sprintf("custom/%s/%s/%s", treasury.QuerierRoute, treasury.QueryIndicator, indicator)
+		if epochStr := vars[RestEpoch]; len(epochStr) != 0 {
+			epoch, ok := sdk.NewIntFromString(epochStr)
+			if !ok {
+				err := fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			route = fmt.Sprintf("%s/%s", route, epoch)
+		}
+
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryIndicatorSeriesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		indicator := vars[RestIndicator]
+
+		var epochs []sdk.Int
+		for _, epochStr := range []string{vars[RestStartEpoch], vars[RestEndEpoch]} {
+			epoch, ok := sdk.NewIntFromString(epochStr)
+			if !ok {
+				err := fmt.Errorf("the given epoch {%s} is not a valid format; epoch should be formatted as an integer", epochStr)
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			epochs = append(epochs, epoch)
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s/%s", treasury.QuerierRoute, treasury.QueryIndicatorSeries, indicator, epochs[0], epochs[1]), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryNextPolicyHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryNextPolicy), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
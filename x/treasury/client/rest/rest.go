@@ -13,9 +13,18 @@ const (
 	RestDenom = "denom"
 	RestDay   = "day"
 	RestEpoch = "epoch"
+
+	RestPrincipal = "principal"
+
+	RestIndicator  = "indicator"
+	RestStartEpoch = "start-epoch"
+	RestEndEpoch   = "end-epoch"
+
+	RestExemptionID = "exemption-id"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	registerTxRoutes(cliCtx, r, cdc)
 	registerQueryRoutes(cliCtx, r, cdc)
 }
@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/terra-project/core/x/treasury"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/submit", treasury.QueryTaxExemptions), submitTaxExemptionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}/withdraw", treasury.QueryTaxExemptions, RestExemptionID), withdrawTaxExemptionHandlerFn(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/treasury/%s/{%s}/votes", treasury.QueryTaxExemptions, RestExemptionID), voteTaxExemptionHandlerFn(cdc, cliCtx)).Methods("POST")
+}
+
+type submitTaxExemptionReq struct {
+	BaseReq     rest.BaseReq     `json:"base_req"`
+	Title       string           `json:"title"`       // Title of the exemption
+	Description string           `json:"description"` // Description of the exemption
+	Addresses   []sdk.AccAddress `json:"addresses"`   // Addresses exempted from taxes among each other
+}
+
+type voteTaxExemptionReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Option  bool         `json:"option"` // option chosen by the voter
+}
+
+type withdrawTaxExemptionReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func submitTaxExemptionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req submitTaxExemptionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAccount, err := cliCtx.GetAccount(fromAddress)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Query params to get deposit amount
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", treasury.QuerierRoute, treasury.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var params treasury.Params
+		cdc.MustUnmarshalJSON(res, &params)
+
+		if fromAccount.GetCoins().AmountOf(params.ExemptionDeposit.Denom).LT(params.ExemptionDeposit.Amount) {
+			err := fmt.Errorf("account %s has insufficient amount of coins to pay a deposit; required %s, given %s",
+				fromAddress, params.ExemptionDeposit, fromAccount.GetCoins())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := treasury.NewMsgSubmitTaxExemption(req.Title, req.Description, req.Addresses, fromAddress)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func withdrawTaxExemptionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		exemptionID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestExemptionID])
+		if !ok {
+			return
+		}
+
+		var req withdrawTaxExemptionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := treasury.NewMsgWithdrawTaxExemption(exemptionID, fromAddress)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func voteTaxExemptionHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		exemptionID, ok := rest.ParseUint64OrReturnBadRequest(w, vars[RestExemptionID])
+		if !ok {
+			return
+		}
+
+		var req voteTaxExemptionReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := treasury.NewMsgVoteTaxExemption(exemptionID, req.Option, fromAddress)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
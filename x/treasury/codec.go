@@ -11,6 +11,10 @@ var cdc = codec.New()
 // RegisterCodec registers concrete types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&types.Claim{}, "treasury/Claim", nil)
+
+	cdc.RegisterConcrete(MsgSubmitTaxExemption{}, "treasury/MsgSubmitTaxExemption", nil)
+	cdc.RegisterConcrete(MsgWithdrawTaxExemption{}, "treasury/MsgWithdrawTaxExemption", nil)
+	cdc.RegisterConcrete(MsgVoteTaxExemption{}, "treasury/MsgVoteTaxExemption", nil)
 }
 
 func init() {
@@ -2,7 +2,6 @@ package treasury
 
 import (
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,7 +12,7 @@ func TestConstraintClamp(t *testing.T) {
 	input := createTestInput(t)
 
 	taxPolicy := input.treasuryKeeper.GetParams(input.ctx).TaxPolicy
-	prevRate := input.treasuryKeeper.GetTaxRate(input.ctx, util.GetEpoch(input.ctx))
+	prevRate := input.treasuryKeeper.GetTaxRate(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
 
 	// Case 1: try to update delta > maxUpdateRate
 	newRate := prevRate.Add(taxPolicy.ChangeRateMax.MulInt64(2))
@@ -48,7 +47,7 @@ func TestConstraintCap(t *testing.T) {
 	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroGBPDenom, sdk.NewDec(1))
 
 	// Check that SDR tax cap has been set
-	require.Equal(t, taxPolicy.Cap.Amount, input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroSDRDenom))
-	require.Equal(t, sdk.NewInt(100).MulRaw(assets.MicroUnit), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom))
-	require.Equal(t, sdk.NewDecFromIntWithPrec(sdk.OneInt(), 1).MulInt64(assets.MicroUnit).TruncateInt(), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroGBPDenom))
+	require.Equal(t, taxPolicy.Cap.Amount, input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroSDRDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+	require.Equal(t, sdk.NewInt(100).MulRaw(assets.MicroUnit), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+	require.Equal(t, sdk.NewDecFromIntWithPrec(sdk.OneInt(), 1).MulInt64(assets.MicroUnit).TruncateInt(), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroGBPDenom, input.calendarKeeper.GetEpoch(input.ctx)))
 }
@@ -1,6 +1,8 @@
 package treasury
 
 import (
+	"strconv"
+
 	"github.com/terra-project/core/types/util"
 	"github.com/terra-project/core/x/treasury/tags"
 
@@ -10,30 +12,166 @@ import (
 // not enough data collected to update variables
 func isProbationPeriod(ctx sdk.Context, k Keeper) bool {
 
-	// Look 1 block into the future ... at the last block of the epoch, trigger
-	futureCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	futureEpoch := util.GetEpoch(futureCtx)
+	// Look 1 epoch into the future ... at the last block of the epoch, trigger
+	futureEpoch := k.ck.GetEpoch(ctx).Add(sdk.OneInt())
 
 	return futureEpoch.LT(k.GetParams(ctx).WindowProbation)
 }
 
-// EndBlocker called to adjust macro weights (tax, mining reward) and settle outstanding claims.
+// pruneHistory drops the epochs that fall out of the retention window of the next epoch
+func pruneHistory(ctx sdk.Context, k Keeper) {
+	retention := k.GetParams(ctx).HistoryRetention
+	if retention.IsZero() {
+		return
+	}
+
+	cutoffEpoch := k.ck.GetEpoch(ctx).Add(sdk.OneInt()).Sub(retention)
+	if cutoffEpoch.IsPositive() {
+		k.PruneHistory(ctx, cutoffEpoch)
+	}
+}
+
+// tallyExemption returns the net yes votes (yes minus no) on a tax exemption, as well as the total
+// voting power. Power is denominated in validator bonded tokens; votes from accounts that are
+// no longer validators are deleted.
+func tallyExemption(ctx sdk.Context, k Keeper, exemptionID uint64) (votePower sdk.Int, totalPower sdk.Int) {
+	votePower = sdk.ZeroInt()
+	totalPower = k.valset.TotalBondedTokens(ctx)
+
+	k.IterateExemptionVotes(ctx, exemptionID, func(voter sdk.AccAddress, option bool) (stop bool) {
+		if validator := k.valset.Validator(ctx, sdk.ValAddress(voter)); validator != nil {
+			bondSize := validator.GetBondedTokens()
+			if option {
+				votePower = votePower.Add(bondSize)
+			} else {
+				votePower = votePower.Sub(bondSize)
+			}
+		} else {
+			k.DeleteExemptionVote(ctx, exemptionID, voter)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// clearsThreshold returns true if totalPower * threshold <= votePower
+func clearsThreshold(votePower, totalPower sdk.Int, threshold sdk.Dec) bool {
+	return votePower.GTE(threshold.MulInt(totalPower).RoundInt())
+}
+
+// updateTaxExemptions tallies the tax exemptions. Pending exemptions whose voting period is over
+// are activated or rejected; active exemptions that lost their support are removed.
+func updateTaxExemptions(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	params := k.GetParams(ctx)
+	resTags = sdk.EmptyTags()
+
+	var exemptions TaxExemptions
+	k.IterateTaxExemptions(ctx, func(exemption TaxExemption) (stop bool) {
+		exemptions = append(exemptions, exemption)
+		return false
+	})
+
+	for _, exemption := range exemptions {
+		if !exemption.Active && exemption.VotingEndBlock > ctx.BlockHeight() {
+			continue
+		}
+
+		votePower, totalPower := tallyExemption(ctx, k, exemption.ExemptionID)
+		passed := clearsThreshold(votePower, totalPower, params.ExemptionThreshold)
+
+		switch {
+		case passed && !exemption.Active:
+			exemption.Active = true
+			k.StoreTaxExemption(ctx, exemption)
+			resTags = resTags.AppendTag(tags.Action, tags.ActionExemptionPassed)
+		case !passed && !exemption.Active:
+			k.DeleteTaxExemption(ctx, exemption.ExemptionID)
+			resTags = resTags.AppendTag(tags.Action, tags.ActionExemptionRejected)
+		case !passed:
+			k.DeleteTaxExemption(ctx, exemption.ExemptionID)
+			resTags = resTags.AppendTag(tags.Action, tags.ActionExemptionRemoved)
+		default:
+			continue
+		}
+
+		resTags = resTags.AppendTags(
+			sdk.NewTags(
+				tags.ExemptionID, strconv.FormatUint(exemption.ExemptionID, 10),
+				tags.Weight, votePower.String(),
+			),
+		)
+	}
+
+	return
+}
+
+// EndBlocker called to adjust macro weights (tax, mining reward) and tax caps, allocate the seigniorage
+// of the epoch, prune old epochs and tally tax exemptions.
 func EndBlocker(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
-	if !util.IsPeriodLastBlock(ctx, util.BlocksPerEpoch) {
-		return resTags
+	resTags = sdk.EmptyTags()
+
+	if k.ck.IsEpochLastBlock(ctx) {
+		resTags = resTags.AppendTags(updateEpochPolicy(ctx, k))
+		resTags = resTags.AppendTags(allocateSeigniorage(ctx, k))
+	}
+
+	// Exemption votes end at a height recorded at submission, so their period stays counted in blocks
+	// whatever the calendar mode
+	if util.IsPeriodLastBlock(ctx, k.GetParams(ctx).ExemptionVotePeriod) {
+		resTags = resTags.AppendTags(updateTaxExemptions(ctx, k))
+	}
+
+	return
+}
+
+// allocateSeigniorage allocates the seigniorage of the epoch at its last block. It runs after the
+// policy update, which reads the seigniorage before the allocation re-issues any of it.
+func allocateSeigniorage(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	allocation, err := k.allocateSeigniorage(ctx)
+
+	// never return err, but handle err for lint
+	if err != nil {
+		panic(err)
 	}
 
+	return sdk.NewTags(
+		tags.Action, tags.ActionSeigniorageAllocation,
+		tags.MinerRewards, allocation.MinerRewards.String(),
+		tags.Oracle, allocation.OracleRewards.String(),
+		tags.Budget, allocation.BudgetPrograms.String(),
+		tags.Community, allocation.CommunityPool.String(),
+		tags.Burned, allocation.Burned.String(),
+	)
+}
+
+// updateEpochPolicy runs at the last block of every epoch
+func updateEpochPolicy(ctx sdk.Context, k Keeper) (resTags sdk.Tags) {
+	updateTaxCaps(ctx, k)
+	pruneHistory(ctx, k)
+
 	if isProbationPeriod(ctx, k) {
 		return resTags
 	}
 
 	// Update policy weights
 	taxRate := updateTaxPolicy(ctx, k)
+	denomTaxRates := updateDenomTaxPolicies(ctx, k)
 	rewardWeight := updateRewardPolicy(ctx, k)
 
-	return sdk.NewTags(
+	resTags = sdk.NewTags(
 		tags.Action, tags.ActionPolicyUpdate,
-		tags.Tax, taxRate.String(),
-		tags.MinerReward, rewardWeight.String(),
+		tags.Tax, taxRate.NewTaxRate.String(),
+		tags.MinerReward, rewardWeight.NewRewardWeight.String(),
 	)
+
+	for _, denom := range k.GetParams(ctx).TaxRateDenoms {
+		resTags = resTags.AppendTags(sdk.NewTags(
+			tags.Denom, denom,
+			tags.DenomTax, denomTaxRates[denom].String(),
+		))
+	}
+
+	return
 }
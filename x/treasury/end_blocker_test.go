@@ -7,8 +7,11 @@ import (
 
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/treasury/tags"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -32,6 +35,75 @@ func TestEndBlockerTiming(t *testing.T) {
 	}
 }
 
+func TestEndBlockerCustomCalendar(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	// 7 days of 70 blocks; the default epoch is not a multiple of the custom one
+	blocksPerEpoch := int64(490)
+	calendarParams := calendar.DefaultParams()
+	calendarParams.BlocksPerDay = 70
+	calendarParams.BlocksPerEpoch = blocksPerEpoch
+	input.calendarKeeper.SetParams(input.ctx, calendarParams)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	lastBlock := (params.WindowProbation.Int64()+1)*blocksPerEpoch - 1
+
+	// Policy updates follow the calendar epochs
+	input.ctx = input.ctx.WithBlockHeight(lastBlock)
+	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, uLunaAmt))
+	tTags := EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, []byte(tags.ActionPolicyUpdate), tTags.ToKVPairs()[0].GetValue())
+	require.Equal(t, params.WindowProbation, input.calendarKeeper.GetEpoch(input.ctx))
+
+	// The default epoch length no longer matters
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch - 1)
+	tTags = EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, 0, len(tTags.ToKVPairs()))
+}
+
+func TestEndBlockerTimeCalendar(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	// 5 minute epochs of 1 minute days, whatever the block time
+	genesisTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+	calendarParams := calendar.DefaultParams()
+	calendarParams.Mode = calendar.ModeTime
+	calendarParams.DayDuration = time.Minute
+	calendarParams.EpochDuration = 5 * time.Minute
+	calendar.InitGenesis(input.ctx, input.calendarKeeper, calendar.NewGenesisState(calendarParams, 0, genesisTime))
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	numEpochs := params.WindowProbation.Int64() + 2
+
+	// Blocks every 7 to 13 seconds
+	blockTime := genesisTime
+	policyUpdates := int64(0)
+	for height := int64(1); input.calendarKeeper.GetEpoch(input.ctx).Int64() < numEpochs; height++ {
+		blockTime = blockTime.Add(time.Duration(7+height%7) * time.Second)
+		input.ctx = input.ctx.WithBlockHeight(height).WithBlockHeader(abci.Header{Height: height, Time: blockTime})
+		calendar.BeginBlocker(input.ctx, input.calendarKeeper)
+
+		tTags := EndBlocker(input.ctx, input.treasuryKeeper)
+		if len(tTags) == 0 {
+			continue
+		}
+
+		// The seigniorage is allocated at the end of every epoch, and policies are updated past the probation period
+		require.True(t, input.calendarKeeper.IsEpochLastBlock(input.ctx))
+		if input.calendarKeeper.GetEpoch(input.ctx).AddRaw(1).LT(params.WindowProbation) {
+			require.Equal(t, []byte(tags.ActionSeigniorageAllocation), tTags.ToKVPairs()[0].GetValue())
+			continue
+		}
+
+		require.Equal(t, []byte(tags.ActionPolicyUpdate), tTags.ToKVPairs()[0].GetValue())
+		policyUpdates++
+	}
+
+	require.Equal(t, numEpochs-params.WindowProbation.Int64()+1, policyUpdates)
+}
+
 func reset(input testInput) testInput {
 
 	// Set blocknum back to 0
@@ -78,8 +150,8 @@ func updatePolicy(input testInput, startIndex int,
 		EndBlocker(input.ctx, input.treasuryKeeper)
 	}
 
-	taxRate = input.treasuryKeeper.GetTaxRate(input.ctx, util.GetEpoch(input.ctx))
-	rewardWeight = input.treasuryKeeper.GetRewardWeight(input.ctx, util.GetEpoch(input.ctx))
+	taxRate = input.treasuryKeeper.GetTaxRate(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
+	rewardWeight = input.treasuryKeeper.GetRewardWeight(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
 	ctx = input.ctx
 
 	return
@@ -100,6 +172,38 @@ func generateRandomMacroInputs() (taxRevenues, seigniorageRevenues []sdk.Int) {
 	return
 }
 
+func TestEndBlockerAllocateSeigniorage(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.OracleSeigniorageShare = sdk.NewDecWithPrec(5, 1)
+	params.BudgetSeigniorageShare = sdk.NewDecWithPrec(5, 1)
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	// Swaps burn 1000 Luna in the first epoch
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch - 2)
+	err := input.mintKeeper.Burn(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(1000)))
+	require.Nil(t, err)
+
+	// Nothing is allocated before the last block of the epoch
+	tTags := EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, 0, len(tTags.ToKVPairs()))
+	require.True(t, input.treasuryKeeper.GetSeigniorageAllocation(input.ctx, sdk.ZeroInt()).BudgetPrograms.IsZero())
+
+	// The allocation is recorded within the probation period as well
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch - 1)
+	tTags = EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, []byte(tags.ActionSeigniorageAllocation), tTags.ToKVPairs()[0].GetValue())
+
+	allocation := input.treasuryKeeper.GetSeigniorageAllocation(input.ctx, sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(50), allocation.MinerRewards)
+	require.Equal(t, sdk.NewInt(475), allocation.OracleRewards)
+	require.Equal(t, sdk.NewInt(475), allocation.BudgetPrograms)
+	require.True(t, allocation.CommunityPool.IsZero())
+	require.True(t, allocation.Burned.IsZero())
+}
+
 func TestEndBlockerUpdatePolicy(t *testing.T) {
 	input := createTestInput(t)
 	input = reset(input)
@@ -109,9 +213,174 @@ func TestEndBlockerUpdatePolicy(t *testing.T) {
 	require.Nil(t, err)
 
 	input.ctx = ctx
-	taxRate := input.treasuryKeeper.GetTaxRate(input.ctx, util.GetEpoch(input.ctx))
-	rewardWeight := input.treasuryKeeper.GetRewardWeight(input.ctx, util.GetEpoch(input.ctx))
+	taxRate := input.treasuryKeeper.GetTaxRate(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
+	rewardWeight := input.treasuryKeeper.GetRewardWeight(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, taxRate, newTaxRate)
 	require.Equal(t, rewardWeight, newSeigniorageWeight)
 }
+
+func TestEndBlockerPruneHistory(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	retention := params.HistoryRetention.Int64()
+
+	// Record tax proceeds and run the end blocker of every epoch past the retention window
+	lastEpoch := retention + 5
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		input.ctx = input.ctx.WithBlockHeight(epoch * util.BlocksPerEpoch)
+		input.treasuryKeeper.RecordTaxProceeds(input.ctx, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(100))})
+
+		input.ctx = input.ctx.WithBlockHeight((epoch+1)*util.BlocksPerEpoch - 1)
+		EndBlocker(input.ctx, input.treasuryKeeper)
+	}
+
+	cutoffEpoch := sdk.NewInt(lastEpoch + 1 - retention)
+	require.Equal(t, cutoffEpoch, input.treasuryKeeper.GetOldestEpoch(input.ctx))
+
+	store := input.ctx.KVStore(input.treasuryKeeper.key)
+	for epoch := int64(0); epoch < cutoffEpoch.Int64(); epoch++ {
+		require.Nil(t, store.Get(keyTaxRate(sdk.NewInt(epoch))))
+		require.Nil(t, store.Get(keyRewardWeight(sdk.NewInt(epoch))))
+		require.Nil(t, store.Get(keyTaxProceeds(sdk.NewInt(epoch))))
+	}
+
+	// The cutoff epoch keeps its rates so that later epochs resolve without a fallback
+	require.NotNil(t, store.Get(keyTaxRate(cutoffEpoch)))
+	require.NotNil(t, store.Get(keyRewardWeight(cutoffEpoch)))
+	require.Equal(t, sdk.NewInt(100), input.treasuryKeeper.PeekTaxProceeds(input.ctx, cutoffEpoch).AmountOf(assets.MicroSDRDenom))
+
+	require.NotNil(t, input.treasuryKeeper.ValidateEpoch(input.ctx, cutoffEpoch.Sub(sdk.OneInt())))
+	require.Nil(t, input.treasuryKeeper.ValidateEpoch(input.ctx, cutoffEpoch))
+}
+
+func TestEndBlockerUpdateTaxCaps(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	sdrCap := input.treasuryKeeper.GetParams(input.ctx).TaxPolicy.Cap
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, sdk.NewDec(1))
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1000))
+
+	// Lookups do not record caps; the krw cap is computed from the price until the epoch ends
+	require.Equal(t, sdrCap.Amount.MulRaw(1000), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, sdk.ZeroInt()))
+
+	// Whitelisted denoms get a cap for the next epoch, even if they were never tracked
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch - 1)
+	EndBlocker(input.ctx, input.treasuryKeeper)
+
+	// Krw price moves; the cap of the next epoch follows it
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(1200))
+	input.ctx = input.ctx.WithBlockHeight(2*util.BlocksPerEpoch - 1)
+	EndBlocker(input.ctx, input.treasuryKeeper)
+
+	// Epochs after the current one resolve to the current cap
+	require.Equal(t, sdrCap.Amount.MulRaw(1000), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, sdk.NewInt(2)))
+
+	input.ctx = input.ctx.WithBlockHeight(2 * util.BlocksPerEpoch)
+	require.Equal(t, sdrCap.Amount.MulRaw(1000), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, sdk.OneInt()))
+	require.Equal(t, sdrCap.Amount.MulRaw(1200), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, sdk.NewInt(2)))
+	require.Equal(t, sdrCap.Amount, input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroSDRDenom, sdk.NewInt(2)))
+
+	// Without a price the previous cap is carried over
+	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.ZeroDec())
+	input.ctx = input.ctx.WithBlockHeight(3*util.BlocksPerEpoch - 1)
+	EndBlocker(input.ctx, input.treasuryKeeper)
+
+	input.ctx = input.ctx.WithBlockHeight(3 * util.BlocksPerEpoch)
+	require.Equal(t, sdrCap.Amount.MulRaw(1200), input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, sdk.NewInt(3)))
+}
+
+func TestEndBlockerUpdateDenomTaxRates(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.TaxRateDenoms = []string{assets.MicroKRWDenom, assets.MicroCNYDenom}
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	// Krw collects as much tax as sdr every epoch; cny collects nothing
+	lastEpoch := params.WindowProbation.Int64()
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		input.ctx = input.ctx.WithBlockHeight(epoch * util.BlocksPerEpoch)
+		input.treasuryKeeper.RecordTaxProceeds(input.ctx, sdk.NewCoins(
+			sdk.NewCoin(assets.MicroKRWDenom, sdk.NewInt(100)),
+			sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(100)),
+		))
+	}
+
+	oldTaxRate := input.treasuryKeeper.GetTaxRate(input.ctx, sdk.NewInt(lastEpoch))
+
+	input.ctx = input.ctx.WithBlockHeight((lastEpoch+1)*util.BlocksPerEpoch - 1)
+	tTags := EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, []byte(tags.ActionPolicyUpdate), tTags.ToKVPairs()[0].GetValue())
+
+	nextEpoch := sdk.NewInt(lastEpoch + 1)
+	taxRate := input.treasuryKeeper.GetTaxRate(input.ctx, nextEpoch)
+
+	// Krw (no price) contributes nothing to the single rate indicator, so both see the same proceeds
+	require.Equal(t, taxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, nextEpoch))
+
+	// No revenues in cny; its rate is hiked as much as the policy allows
+	require.Equal(t, oldTaxRate.Add(params.TaxPolicy.ChangeRateMax),
+		input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroCNYDenom, nextEpoch))
+
+	// Unlisted denoms pay the single rate
+	require.Equal(t, taxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroSDRDenom, nextEpoch))
+}
+
+func TestEndBlockerTallyTaxExemptions(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.treasuryKeeper)
+	params := input.treasuryKeeper.GetParams(input.ctx)
+
+	// Two exemptions; the first gets two yes votes and one no, the second one yes and two no
+	for i := 0; i < 2; i++ {
+		deposit := params.ExemptionDeposit
+		err := input.mintKeeper.Mint(input.ctx, addrs[0], deposit)
+		require.NoError(t, err)
+
+		res := h(input.ctx, NewMsgSubmitTaxExemption("test", "testdescription", []sdk.AccAddress{addrs[i], addrs[i+1]}, addrs[0]))
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 1, addrs[0], true)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 1, addrs[1], true)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 1, addrs[2], false)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 2, addrs[0], true)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 2, addrs[1], false)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 2, addrs[2], false)
+
+	// Voting is still open; nothing is tallied
+	input.ctx = input.ctx.WithBlockHeight(params.ExemptionVotePeriod - 1)
+	updateTaxExemptions(input.ctx, input.treasuryKeeper)
+	exemption, err := input.treasuryKeeper.GetTaxExemption(input.ctx, 1)
+	require.Nil(t, err)
+	require.False(t, exemption.Active)
+
+	// First tally after the end of the voting period
+	input.ctx = input.ctx.WithBlockHeight(2*params.ExemptionVotePeriod - 1)
+	updateTaxExemptions(input.ctx, input.treasuryKeeper)
+
+	exemption, err = input.treasuryKeeper.GetTaxExemption(input.ctx, 1)
+	require.Nil(t, err)
+	require.True(t, exemption.Active)
+	require.True(t, input.treasuryKeeper.IsExemptTransfer(input.ctx, addrs[0], []sdk.AccAddress{addrs[1]}))
+	require.True(t, input.treasuryKeeper.IsExemptTransfer(input.ctx, addrs[1], []sdk.AccAddress{addrs[0]}))
+	require.False(t, input.treasuryKeeper.IsExemptTransfer(input.ctx, addrs[0], []sdk.AccAddress{addrs[1], addrs[2]}))
+
+	_, err = input.treasuryKeeper.GetTaxExemption(input.ctx, 2)
+	require.NotNil(t, err)
+
+	// The active exemption is removed once it loses its support
+	input.treasuryKeeper.AddExemptionVote(input.ctx, 1, addrs[1], false)
+	input.ctx = input.ctx.WithBlockHeight(3*params.ExemptionVotePeriod - 1)
+	updateTaxExemptions(input.ctx, input.treasuryKeeper)
+
+	_, err = input.treasuryKeeper.GetTaxExemption(input.ctx, 1)
+	require.NotNil(t, err)
+	require.False(t, input.treasuryKeeper.IsExemptTransfer(input.ctx, addrs[0], []sdk.AccAddress{addrs[1]}))
+}
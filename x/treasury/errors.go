@@ -0,0 +1,70 @@
+package treasury
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// treasury error codes
+const (
+	DefaultCodespace sdk.CodespaceType = "treasury"
+
+	CodeHistoryPruned         sdk.CodeType = 1
+	CodeExemptionNotFound     sdk.CodeType = 2
+	CodeInvalidExemptionID    sdk.CodeType = 3
+	CodeInvalidSubmitter      sdk.CodeType = 4
+	CodeInvalidTitle          sdk.CodeType = 5
+	CodeInvalidDescription    sdk.CodeType = 6
+	CodeInvalidExemptionSet   sdk.CodeType = 7
+	CodeExemptionRefundFailed sdk.CodeType = 8
+	CodeInvalidEpoch          sdk.CodeType = 9
+)
+
+// ----------------------------------------
+// Error constructors
+
+// ErrHistoryPruned called when the history of the requested epoch was pruned from the store
+func ErrHistoryPruned(codespace sdk.CodespaceType, epoch, oldestEpoch sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeHistoryPruned, fmt.Sprintf("History of epoch %s was pruned; the oldest retained epoch is %s", epoch, oldestEpoch))
+}
+
+// ErrInvalidEpoch called when the requested epoch or epoch range cannot be evaluated
+func ErrInvalidEpoch(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEpoch, fmt.Sprintf("Invalid epoch: %s", msg))
+}
+
+// ErrExemptionNotFound called when the requested tax exemption does not exist
+func ErrExemptionNotFound(codespace sdk.CodespaceType, exemptionID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeExemptionNotFound, fmt.Sprintf("Tax exemption with id %d not found", exemptionID))
+}
+
+// ErrInvalidExemptionID called when the tax exemption id is zero
+func ErrInvalidExemptionID(codespace sdk.CodespaceType, exemptionID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExemptionID, fmt.Sprintf("Tax exemption id %d invalid; ids start from 1", exemptionID))
+}
+
+// ErrInvalidSubmitter called when someone other than the submitter withdraws a tax exemption
+func ErrInvalidSubmitter(codespace sdk.CodespaceType, submitter sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSubmitter, fmt.Sprintf("Submitter does not match %s", submitter))
+}
+
+// ErrInvalidTitle called when a tax exemption is submitted with an empty title
+func ErrInvalidTitle(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTitle, "Cannot submit a tax exemption with empty title")
+}
+
+// ErrInvalidDescription called when a tax exemption is submitted with an empty description
+func ErrInvalidDescription(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDescription, "Cannot submit a tax exemption with empty description")
+}
+
+// ErrInvalidExemptionSet called when the exempted addresses are fewer than two or duplicated
+func ErrInvalidExemptionSet(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExemptionSet, fmt.Sprintf("Invalid tax exemption addresses: %s", msg))
+}
+
+// ErrExemptionRefundFailed called when the deposit of a withdrawn tax exemption cannot be refunded
+func ErrExemptionRefundFailed(codespace sdk.CodespaceType, submitter sdk.AccAddress, exemptionID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeExemptionRefundFailed, fmt.Sprintf("Refund failed to %s of tax exemption %d", submitter, exemptionID))
+}
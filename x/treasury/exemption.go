@@ -0,0 +1,88 @@
+package treasury
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TaxExemption defines a set of addresses that may transfer funds among each other
+// without paying the stability tax. A sender/recipient pair is a set of two addresses.
+type TaxExemption struct {
+	ExemptionID    uint64           `json:"exemption_id"`     // ID of the exemption
+	Title          string           `json:"title"`            // Title of the exemption
+	Description    string           `json:"description"`      // Description of the exemption
+	Addresses      []sdk.AccAddress `json:"addresses"`        // Addresses exempted from taxes among each other
+	Submitter      sdk.AccAddress   `json:"submitter"`        // Address of the submitter
+	SubmitBlock    int64            `json:"submit_block"`     // Block height at which the exemption was submitted
+	VotingEndBlock int64            `json:"voting_end_block"` // Block height after which the first tally decides on the exemption
+	Active         bool             `json:"active"`           // True once the exemption passed its first tally
+}
+
+// NewTaxExemption creates a new pending TaxExemption
+func NewTaxExemption(exemptionID uint64, title, description string, addresses []sdk.AccAddress,
+	submitter sdk.AccAddress, submitBlock, votingEndBlock int64) TaxExemption {
+	return TaxExemption{
+		ExemptionID:    exemptionID,
+		Title:          title,
+		Description:    description,
+		Addresses:      addresses,
+		Submitter:      submitter,
+		SubmitBlock:    submitBlock,
+		VotingEndBlock: votingEndBlock,
+		Active:         false,
+	}
+}
+
+// String implements fmt.Stringer
+func (e TaxExemption) String() string {
+	return fmt.Sprintf(`TaxExemption
+	ExemptionID: %d
+	Title: %s
+	Description: %s
+	Addresses: %v
+	Submitter: %v
+	SubmitBlock: %d
+	VotingEndBlock: %d
+	Active: %v`,
+		e.ExemptionID, e.Title, e.Description, e.Addresses, e.Submitter,
+		e.SubmitBlock, e.VotingEndBlock, e.Active)
+}
+
+// TaxExemptions is a collection of TaxExemption
+type TaxExemptions []TaxExemption
+
+func (e TaxExemptions) String() (out string) {
+	for _, val := range e {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ExemptionVote defines the vote of a validator on a tax exemption
+type ExemptionVote MsgVoteTaxExemption
+
+// NewExemptionVote creates an ExemptionVote instance
+func NewExemptionVote(exemptionID uint64, option bool, voter sdk.AccAddress) ExemptionVote {
+	return ExemptionVote(
+		MsgVoteTaxExemption{
+			ExemptionID: exemptionID,
+			Option:      option,
+			Voter:       voter,
+		})
+}
+
+func (v ExemptionVote) String() string {
+	return MsgVoteTaxExemption(v).String()
+}
+
+// ExemptionVotes is a collection of ExemptionVote
+type ExemptionVotes []ExemptionVote
+
+func (v ExemptionVotes) String() (out string) {
+	for _, val := range v {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
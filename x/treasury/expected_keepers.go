@@ -1,12 +1,22 @@
 package treasury
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"github.com/terra-project/core/x/oracle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+)
 
 // expected mint keeper
 type MintKeeper interface {
 	PeekEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) (seignioragePool sdk.Int)
 	Mint(ctx sdk.Context, recipient sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
+	Burn(ctx sdk.Context, payer sdk.AccAddress, coin sdk.Coin) (err sdk.Error)
 	GetIssuance(ctx sdk.Context, denom string, day sdk.Int) (issuance sdk.Int)
+	ValidateIssuanceDay(ctx sdk.Context, day sdk.Int) sdk.Error
+	ValidateEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) sdk.Error
+	ChangeIssuance(ctx sdk.Context, denom string, delta sdk.Int) (err sdk.Error)
+	ChangeNotBondedTokens(ctx sdk.Context, delta sdk.Int)
 }
 
 // expected market keeper
@@ -15,12 +25,33 @@ type MarketKeeper interface {
 	GetSwapCoin(ctx sdk.Context, offerCoin sdk.Coin, askDenom string, isInternal bool) (sdk.Coin, sdk.Dec, sdk.Error)
 }
 
-// expected coin keeper
+// expected oracle keeper
+type OracleKeeper interface {
+	AddSwapFeePool(ctx sdk.Context, fees sdk.Coins)
+	GetActiveDenoms(ctx sdk.Context) (denoms oracle.DenomList)
+}
+
+// expected calendar keeper
+type CalendarKeeper interface {
+	GetDay(ctx sdk.Context) sdk.Int
+	GetEpoch(ctx sdk.Context) sdk.Int
+	IsEpochLastBlock(ctx sdk.Context) bool
+	EpochFirstBlock(ctx sdk.Context, epoch sdk.Int) int64
+	EpochLastBlock(ctx sdk.Context, epoch sdk.Int) int64
+}
+
+// expected distribution keeper
 type DistributionKeeper interface {
-	AllocateTokensToValidator(ctx sdk.Context, val sdk.Validator, tokens sdk.DecCoins)
+	GetFeePool(ctx sdk.Context) (feePool distr.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distr.FeePool)
 }
 
 // expected fee keeper
 type FeeCollectionKeeper interface {
 	AddCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins
 }
+
+// expected crisis keeper
+type CrisisKeeper interface {
+	RegisterRoute(moduleName, route string, invar sdk.Invariant)
+}
@@ -2,25 +2,46 @@ package treasury
 
 import (
 	"fmt"
-
-	"github.com/terra-project/core/types/util"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all treasury state that must be provided at genesis
 type GenesisState struct {
-	Params              Params  `json:"params"` // treasury params
-	GenesisTaxRate      sdk.Dec `json:"tax_rate"`
-	GenesisRewardWeight sdk.Dec `json:"reward_weight"`
+	Params              Params              `json:"params"` // treasury params
+	GenesisTaxRate      sdk.Dec             `json:"tax_rate"`
+	GenesisRewardWeight sdk.Dec             `json:"reward_weight"`
+	TaxRates            []EpochTaxRate      `json:"tax_rates"`      // tax rates of the epochs other than the current one
+	RewardWeights       []EpochRewardWeight `json:"reward_weights"` // reward weights of the epochs other than the current one
+	TaxProceeds         []EpochTaxProceeds  `json:"tax_proceeds"`   // tax proceeds of every stored epoch
+	TaxCaps             []EpochTaxCap       `json:"tax_caps"`       // tax caps of every denom and stored epoch
+	TaxExemptions       TaxExemptions       `json:"tax_exemptions"` // active and pending tax exemptions
+	ExemptionVotes      ExemptionVotes      `json:"exemption_votes"`
+	DenomTaxRates       []EpochDenomTaxRate `json:"denom_tax_rates"` // tax rates of the denoms listed in TaxRateDenoms for every stored epoch
+
+	SeigniorageAllocations SeigniorageAllocations `json:"seigniorage_allocations"` // destinations of the seigniorage of every stored epoch
 }
 
 // NewGenesisState constructs a new genesis state
-func NewGenesisState(params Params, taxRate, rewardWeight sdk.Dec) GenesisState {
+func NewGenesisState(params Params, taxRate, rewardWeight sdk.Dec,
+	taxRates []EpochTaxRate, rewardWeights []EpochRewardWeight,
+	taxProceeds []EpochTaxProceeds, taxCaps []EpochTaxCap,
+	taxExemptions TaxExemptions, exemptionVotes ExemptionVotes,
+	denomTaxRates []EpochDenomTaxRate, seigniorageAllocations SeigniorageAllocations) GenesisState {
 	return GenesisState{
 		Params:              params,
 		GenesisTaxRate:      taxRate,
 		GenesisRewardWeight: rewardWeight,
+		TaxRates:            taxRates,
+		RewardWeights:       rewardWeights,
+		TaxProceeds:         taxProceeds,
+		TaxCaps:             taxCaps,
+		TaxExemptions:       taxExemptions,
+		ExemptionVotes:      exemptionVotes,
+		DenomTaxRates:       denomTaxRates,
+
+		SeigniorageAllocations: seigniorageAllocations,
 	}
 }
 
@@ -31,25 +52,149 @@ func DefaultGenesisState() GenesisState {
 		Params:              params,
 		GenesisTaxRate:      sdk.NewDecWithPrec(1, 3), // 0.1%
 		GenesisRewardWeight: sdk.NewDecWithPrec(5, 2), // 5%
+		TaxRates:            []EpochTaxRate{},
+		RewardWeights:       []EpochRewardWeight{},
+		TaxProceeds:         []EpochTaxProceeds{},
+		TaxCaps:             []EpochTaxCap{},
+		TaxExemptions:       TaxExemptions{},
+		ExemptionVotes:      ExemptionVotes{},
+		DenomTaxRates:       []EpochDenomTaxRate{},
+
+		SeigniorageAllocations: SeigniorageAllocations{},
 	}
 }
 
-// InitGenesis new treasury genesis
+// InitGenesis new treasury genesis. The per-epoch history is restored first;
+// the genesis tax rate and reward weight then apply to the current epoch.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+
+	// Epochs before the first exported record were pruned or never recorded
+	oldestEpoch := keeper.ck.GetEpoch(ctx)
+	for _, taxRate := range data.TaxRates {
+		keeper.setTaxRateAt(ctx, taxRate.Epoch, taxRate.TaxRate)
+		if taxRate.Epoch.LT(oldestEpoch) {
+			oldestEpoch = taxRate.Epoch
+		}
+	}
+
+	for _, taxRate := range data.DenomTaxRates {
+		keeper.setDenomTaxRate(ctx, taxRate.Denom, taxRate.Epoch, taxRate.TaxRate)
+	}
+
+	for _, rewardWeight := range data.RewardWeights {
+		keeper.setRewardWeightAt(ctx, rewardWeight.Epoch, rewardWeight.RewardWeight)
+	}
+
+	for _, taxProceeds := range data.TaxProceeds {
+		keeper.setTaxProceeds(ctx, taxProceeds.Epoch, taxProceeds.TaxProceeds)
+	}
+
+	for _, taxCap := range data.TaxCaps {
+		keeper.setTaxCap(ctx, taxCap.Denom, taxCap.Epoch, taxCap.TaxCap)
+	}
+
+	for _, allocation := range data.SeigniorageAllocations {
+		keeper.SetSeigniorageAllocation(ctx, allocation)
+	}
+
+	if len(data.TaxRates) != 0 {
+		keeper.setOldestEpoch(ctx, oldestEpoch)
+	}
+
+	lastExemptionID := uint64(0)
+	for _, exemption := range data.TaxExemptions {
+		keeper.StoreTaxExemption(ctx, exemption)
+		if exemption.ExemptionID > lastExemptionID {
+			lastExemptionID = exemption.ExemptionID
+		}
+	}
+
+	if lastExemptionID != 0 {
+		keeper.setLastExemptionID(ctx, lastExemptionID)
+	}
+
+	for _, vote := range data.ExemptionVotes {
+		keeper.AddExemptionVote(ctx, vote.ExemptionID, vote.Voter, vote.Option)
+	}
+
 	keeper.SetTaxRate(ctx, data.GenesisTaxRate)
-	keeper.setTaxCap(ctx, data.Params.TaxPolicy.Cap.Denom, data.Params.TaxPolicy.Cap.Amount)
+	keeper.setTaxCap(ctx, data.Params.TaxPolicy.Cap.Denom, keeper.ck.GetEpoch(ctx), data.Params.TaxPolicy.Cap.Amount)
 	keeper.SetRewardWeight(ctx, data.GenesisRewardWeight)
 }
 
-// ExportGenesis returns a GenesisState for a given context and keeper. The
-// GenesisState will contain the pool, and validator/delegator distribution info's
+// ExportGenesis returns a GenesisState for a given context and keeper. The GenesisState
+// contains the tax rate and reward weight of the current epoch, and the full per-epoch
+// history kept in the store, including the policy already set for the next epoch.
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	params := k.GetParams(ctx)
-	taxRate := k.GetTaxRate(ctx, sdk.ZeroInt())
-	rewardWeight := k.GetRewardWeight(ctx, util.GetEpoch(ctx))
+	curEpoch := k.ck.GetEpoch(ctx)
+	taxRate := k.GetTaxRate(ctx, curEpoch)
+	rewardWeight := k.GetRewardWeight(ctx, curEpoch)
+
+	taxRates := []EpochTaxRate{}
+	k.IterateTaxRates(ctx, func(epoch sdk.Int, taxRate sdk.Dec) (stop bool) {
+		if !epoch.Equal(curEpoch) {
+			taxRates = append(taxRates, NewEpochTaxRate(epoch, taxRate))
+		}
+		return false
+	})
+	sort.Slice(taxRates, func(i, j int) bool { return taxRates[i].Epoch.LT(taxRates[j].Epoch) })
+
+	denomTaxRates := []EpochDenomTaxRate{}
+	k.IterateAllDenomTaxRates(ctx, func(epoch sdk.Int, denom string, taxRate sdk.Dec) (stop bool) {
+		denomTaxRates = append(denomTaxRates, NewEpochDenomTaxRate(epoch, denom, taxRate))
+		return false
+	})
+	sort.SliceStable(denomTaxRates, func(i, j int) bool { return denomTaxRates[i].Epoch.LT(denomTaxRates[j].Epoch) })
+
+	rewardWeights := []EpochRewardWeight{}
+	k.IterateRewardWeights(ctx, func(epoch sdk.Int, rewardWeight sdk.Dec) (stop bool) {
+		if !epoch.Equal(curEpoch) {
+			rewardWeights = append(rewardWeights, NewEpochRewardWeight(epoch, rewardWeight))
+		}
+		return false
+	})
+	sort.Slice(rewardWeights, func(i, j int) bool { return rewardWeights[i].Epoch.LT(rewardWeights[j].Epoch) })
+
+	taxProceeds := []EpochTaxProceeds{}
+	k.IterateTaxProceeds(ctx, func(epoch sdk.Int, proceeds sdk.Coins) (stop bool) {
+		taxProceeds = append(taxProceeds, NewEpochTaxProceeds(epoch, proceeds))
+		return false
+	})
+	sort.Slice(taxProceeds, func(i, j int) bool { return taxProceeds[i].Epoch.LT(taxProceeds[j].Epoch) })
 
-	return NewGenesisState(params, taxRate, rewardWeight)
+	taxCaps := []EpochTaxCap{}
+	k.IterateAllTaxCaps(ctx, func(epoch sdk.Int, denom string, taxCap sdk.Int) (stop bool) {
+		taxCaps = append(taxCaps, NewEpochTaxCap(epoch, denom, taxCap))
+		return false
+	})
+	sort.SliceStable(taxCaps, func(i, j int) bool { return taxCaps[i].Epoch.LT(taxCaps[j].Epoch) })
+
+	seigniorageAllocations := SeigniorageAllocations{}
+	k.IterateSeigniorageAllocations(ctx, func(allocation SeigniorageAllocation) (stop bool) {
+		seigniorageAllocations = append(seigniorageAllocations, allocation)
+		return false
+	})
+	sort.Slice(seigniorageAllocations, func(i, j int) bool {
+		return seigniorageAllocations[i].Epoch.LT(seigniorageAllocations[j].Epoch)
+	})
+
+	taxExemptions := TaxExemptions{}
+	k.IterateTaxExemptions(ctx, func(exemption TaxExemption) (stop bool) {
+		taxExemptions = append(taxExemptions, exemption)
+		return false
+	})
+	sort.Slice(taxExemptions, func(i, j int) bool { return taxExemptions[i].ExemptionID < taxExemptions[j].ExemptionID })
+
+	exemptionVotes := ExemptionVotes{}
+	k.IterateAllExemptionVotes(ctx, func(exemptionID uint64, voter sdk.AccAddress, option bool) (stop bool) {
+		exemptionVotes = append(exemptionVotes, NewExemptionVote(exemptionID, option, voter))
+		return false
+	})
+
+	return NewGenesisState(params, taxRate, rewardWeight, taxRates, rewardWeights, taxProceeds, taxCaps,
+		taxExemptions, exemptionVotes, denomTaxRates, seigniorageAllocations)
 }
 
 // ValidateGenesis validates the provided treasury genesis state to ensure the
@@ -67,5 +212,103 @@ func ValidateGenesis(data GenesisState) error {
 			data.Params.RewardPolicy.RateMin, data.Params.RewardPolicy.RateMax, data.GenesisRewardWeight)
 	}
 
+	taxRateMap := make(map[string]bool)
+	for _, taxRate := range data.TaxRates {
+		if taxRate.Epoch.IsNegative() || taxRate.TaxRate.IsNegative() {
+			return fmt.Errorf("Invalid tax rate: %s", taxRate)
+		}
+
+		if _, ok := taxRateMap[taxRate.Epoch.String()]; ok {
+			return fmt.Errorf("Duplicate tax rate for epoch %s", taxRate.Epoch)
+		}
+		taxRateMap[taxRate.Epoch.String()] = true
+	}
+
+	denomTaxRateMap := make(map[string]bool)
+	for _, taxRate := range data.DenomTaxRates {
+		if taxRate.Epoch.IsNegative() || len(taxRate.Denom) == 0 || taxRate.TaxRate.IsNegative() {
+			return fmt.Errorf("Invalid denom tax rate: %s", taxRate)
+		}
+
+		key := string(keyDenomTaxRate(taxRate.Denom, taxRate.Epoch))
+		if _, ok := denomTaxRateMap[key]; ok {
+			return fmt.Errorf("Duplicate tax rate for %s at epoch %s", taxRate.Denom, taxRate.Epoch)
+		}
+		denomTaxRateMap[key] = true
+	}
+
+	rewardWeightMap := make(map[string]bool)
+	for _, rewardWeight := range data.RewardWeights {
+		if rewardWeight.Epoch.IsNegative() || rewardWeight.RewardWeight.IsNegative() {
+			return fmt.Errorf("Invalid reward weight: %s", rewardWeight)
+		}
+
+		if _, ok := rewardWeightMap[rewardWeight.Epoch.String()]; ok {
+			return fmt.Errorf("Duplicate reward weight for epoch %s", rewardWeight.Epoch)
+		}
+		rewardWeightMap[rewardWeight.Epoch.String()] = true
+	}
+
+	taxProceedsMap := make(map[string]bool)
+	for _, taxProceeds := range data.TaxProceeds {
+		if taxProceeds.Epoch.IsNegative() || !taxProceeds.TaxProceeds.IsValid() {
+			return fmt.Errorf("Invalid tax proceeds: %s", taxProceeds)
+		}
+
+		if _, ok := taxProceedsMap[taxProceeds.Epoch.String()]; ok {
+			return fmt.Errorf("Duplicate tax proceeds for epoch %s", taxProceeds.Epoch)
+		}
+		taxProceedsMap[taxProceeds.Epoch.String()] = true
+	}
+
+	taxCapMap := make(map[string]bool)
+	for _, taxCap := range data.TaxCaps {
+		if taxCap.Epoch.IsNegative() || len(taxCap.Denom) == 0 || taxCap.TaxCap.IsNegative() {
+			return fmt.Errorf("Invalid tax cap: %s", taxCap)
+		}
+
+		key := string(keyTaxCap(taxCap.Denom, taxCap.Epoch))
+		if _, ok := taxCapMap[key]; ok {
+			return fmt.Errorf("Duplicate tax cap for %s at epoch %s", taxCap.Denom, taxCap.Epoch)
+		}
+		taxCapMap[key] = true
+	}
+
+	allocationMap := make(map[string]bool)
+	for _, allocation := range data.SeigniorageAllocations {
+		if allocation.Epoch.IsNegative() || allocation.MinerRewards.IsNegative() || allocation.OracleRewards.IsNegative() ||
+			allocation.BudgetPrograms.IsNegative() || allocation.CommunityPool.IsNegative() || allocation.Burned.IsNegative() {
+			return fmt.Errorf("Invalid seigniorage allocation: %s", allocation)
+		}
+
+		if _, ok := allocationMap[allocation.Epoch.String()]; ok {
+			return fmt.Errorf("Duplicate seigniorage allocation for epoch %s", allocation.Epoch)
+		}
+		allocationMap[allocation.Epoch.String()] = true
+	}
+
+	exemptionMap := make(map[uint64]bool)
+	for _, exemption := range data.TaxExemptions {
+		if exemption.ExemptionID == 0 {
+			return fmt.Errorf("Invalid tax exemption id: %s", exemption)
+		}
+
+		if _, ok := exemptionMap[exemption.ExemptionID]; ok {
+			return fmt.Errorf("Duplicate tax exemption id %d", exemption.ExemptionID)
+		}
+		exemptionMap[exemption.ExemptionID] = true
+
+		msg := NewMsgSubmitTaxExemption(exemption.Title, exemption.Description, exemption.Addresses, exemption.Submitter)
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("Invalid tax exemption %d: %s", exemption.ExemptionID, err)
+		}
+	}
+
+	for _, vote := range data.ExemptionVotes {
+		if _, ok := exemptionMap[vote.ExemptionID]; !ok || len(vote.Voter) == 0 {
+			return fmt.Errorf("Invalid tax exemption vote: %s", vote)
+		}
+	}
+
 	return validateParams(data.Params)
 }
@@ -0,0 +1,174 @@
+package treasury
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/calendar"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.TaxRateDenoms = []string{assets.MicroKRWDenom}
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	// Record a policy and tax proceeds for a few epochs
+	epochs := int64(5)
+	for epoch := int64(0); epoch < epochs; epoch++ {
+		ctx := input.ctx.WithBlockHeight(epoch * util.BlocksPerEpoch)
+		input.treasuryKeeper.SetTaxRate(ctx, sdk.NewDecWithPrec(epoch+1, 3))
+		input.treasuryKeeper.SetRewardWeight(ctx, sdk.NewDecWithPrec(epoch+5, 2))
+		input.treasuryKeeper.RecordTaxProceeds(ctx, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(epoch+1))})
+		input.treasuryKeeper.setTaxCap(ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(ctx), sdk.NewInt(epoch+1000))
+		input.treasuryKeeper.setDenomTaxRate(ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(ctx), sdk.NewDecWithPrec(epoch+2, 3))
+		input.treasuryKeeper.SetSeigniorageAllocation(ctx, splitSeigniorage(sdk.NewInt(epoch), sdk.NewInt(epoch*1000), sdk.NewDecWithPrec(epoch+5, 2), params))
+	}
+
+	ctx := input.ctx.WithBlockHeight((epochs-1)*util.BlocksPerEpoch + 1)
+	curEpoch := input.calendarKeeper.GetEpoch(ctx)
+
+	// One active and one pending tax exemption
+	for i := 0; i < 2; i++ {
+		exemption := NewTaxExemption(input.treasuryKeeper.NewExemptionID(ctx), "test", "testdescription",
+			[]sdk.AccAddress{addrs[i], addrs[i+1]}, addrs[0], ctx.BlockHeight(), ctx.BlockHeight()+10)
+		exemption.Active = i == 0
+		input.treasuryKeeper.StoreTaxExemption(ctx, exemption)
+		input.treasuryKeeper.AddExemptionVote(ctx, exemption.ExemptionID, addrs[2], true)
+	}
+
+	genesis := ExportGenesis(ctx, input.treasuryKeeper)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	// The current epoch is exported, not the genesis epoch
+	require.Equal(t, input.treasuryKeeper.GetTaxRate(ctx, curEpoch), genesis.GenesisTaxRate)
+	require.Equal(t, input.treasuryKeeper.GetRewardWeight(ctx, curEpoch), genesis.GenesisRewardWeight)
+
+	// The new chain restarts at height 0 and carries on with the exported epochs
+	calendarGenesis := calendar.ExportGenesis(ctx, input.calendarKeeper)
+	newInput := createTestInput(t)
+	newCtx := newInput.ctx.WithBlockHeight(0)
+	calendar.InitGenesis(newCtx, newInput.calendarKeeper, calendarGenesis)
+	InitGenesis(newCtx, newInput.treasuryKeeper, genesis)
+	require.Equal(t, curEpoch, newInput.calendarKeeper.GetEpoch(newCtx))
+
+	for epoch := int64(0); epoch < epochs; epoch++ {
+		e := sdk.NewInt(epoch)
+		require.Equal(t, input.treasuryKeeper.GetTaxRate(ctx, e), newInput.treasuryKeeper.GetTaxRate(newCtx, e))
+		require.Equal(t, input.treasuryKeeper.GetRewardWeight(ctx, e), newInput.treasuryKeeper.GetRewardWeight(newCtx, e))
+		require.Equal(t, input.treasuryKeeper.PeekTaxProceeds(ctx, e), newInput.treasuryKeeper.PeekTaxProceeds(newCtx, e))
+		require.Equal(t,
+			input.treasuryKeeper.GetTaxCap(ctx, assets.MicroKRWDenom, e),
+			newInput.treasuryKeeper.GetTaxCap(newCtx, assets.MicroKRWDenom, e))
+		require.Equal(t,
+			input.treasuryKeeper.GetDenomTaxRate(ctx, assets.MicroKRWDenom, e),
+			newInput.treasuryKeeper.GetDenomTaxRate(newCtx, assets.MicroKRWDenom, e))
+		require.Equal(t,
+			input.treasuryKeeper.GetSeigniorageAllocation(ctx, e),
+			newInput.treasuryKeeper.GetSeigniorageAllocation(newCtx, e))
+	}
+
+	require.True(t, newInput.treasuryKeeper.IsExemptTransfer(newCtx, addrs[0], []sdk.AccAddress{addrs[1]}))
+	require.False(t, newInput.treasuryKeeper.IsExemptTransfer(newCtx, addrs[1], []sdk.AccAddress{addrs[2]}))
+	require.Equal(t, uint64(3), newInput.treasuryKeeper.NewExemptionID(newCtx))
+
+	newGenesis := ExportGenesis(newCtx, newInput.treasuryKeeper)
+	require.Equal(t, genesis.TaxRates, newGenesis.TaxRates)
+	require.Equal(t, genesis.RewardWeights, newGenesis.RewardWeights)
+	require.Equal(t, genesis.TaxProceeds, newGenesis.TaxProceeds)
+	require.Equal(t, genesis.DenomTaxRates, newGenesis.DenomTaxRates)
+	require.Equal(t, genesis.SeigniorageAllocations, newGenesis.SeigniorageAllocations)
+	require.Equal(t, genesis.TaxExemptions, newGenesis.TaxExemptions)
+	require.Equal(t, genesis.ExemptionVotes, newGenesis.ExemptionVotes)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.TaxRates = []EpochTaxRate{
+		NewEpochTaxRate(sdk.OneInt(), sdk.NewDecWithPrec(1, 3)),
+		NewEpochTaxRate(sdk.OneInt(), sdk.NewDecWithPrec(2, 3)),
+	}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.DenomTaxRates = []EpochDenomTaxRate{
+		NewEpochDenomTaxRate(sdk.OneInt(), assets.MicroKRWDenom, sdk.NewDecWithPrec(1, 3)),
+		NewEpochDenomTaxRate(sdk.OneInt(), assets.MicroKRWDenom, sdk.NewDecWithPrec(2, 3)),
+	}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.TaxRateDenoms = []string{assets.MicroKRWDenom, assets.MicroKRWDenom}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.TaxController = NewControllerParams(ControllerPID, sdk.NewDecWithPrec(1, 3), sdk.ZeroDec(), sdk.ZeroDec(), 4)
+	require.Error(t, ValidateGenesis(genesis))
+
+	// The history must cover WindowLong behind every observed epoch
+	genesis.Params.HistoryRetention = genesis.Params.WindowLong.AddRaw(4)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.HistoryRetention = sdk.ZeroInt()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.TaxController.Window = MaxControllerWindow + 1
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.TaxController.Window = 4
+
+	genesis.Params.TaxController.Type = "unknown"
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.RewardController = NewControllerParams(ControllerPID, sdk.NewDecWithPrec(-1, 3), sdk.ZeroDec(), sdk.ZeroDec(), 4)
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.RewardController = NewControllerParams(ControllerPID, sdk.NewDecWithPrec(1, 3), sdk.ZeroDec(), sdk.ZeroDec(), 0)
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.RewardWeights = []EpochRewardWeight{NewEpochRewardWeight(sdk.OneInt().Neg(), sdk.NewDecWithPrec(5, 2))}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.TaxProceeds = []EpochTaxProceeds{NewEpochTaxProceeds(sdk.OneInt(), sdk.Coins{sdk.Coin{Denom: assets.MicroSDRDenom, Amount: sdk.NewInt(-1)}})}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.TaxCaps = []EpochTaxCap{NewEpochTaxCap(sdk.OneInt(), "", sdk.OneInt())}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.BudgetSeigniorageShare = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis.Params.OracleSeigniorageShare = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.BurnSeigniorageShare = sdk.NewDecWithPrec(-1, 1)
+	genesis.Params.CommunityPoolSeigniorageShare = sdk.NewDecWithPrec(1, 1)
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	allocation := splitSeigniorage(sdk.OneInt(), sdk.NewInt(1000), sdk.NewDecWithPrec(5, 2), genesis.Params)
+	genesis.SeigniorageAllocations = SeigniorageAllocations{allocation, allocation}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.TaxExemptions = TaxExemptions{NewTaxExemption(1, "test", "testdescription", []sdk.AccAddress{addrs[0]}, addrs[0], 0, 10)}
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.ExemptionVotes = ExemptionVotes{NewExemptionVote(1, true, addrs[0])}
+	require.Error(t, ValidateGenesis(genesis))
+}
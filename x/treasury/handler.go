@@ -0,0 +1,111 @@
+package treasury
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/terra-project/core/x/treasury/tags"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// NewHandler creates a new handler for all treasury type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitTaxExemption:
+			return handleMsgSubmitTaxExemption(ctx, k, msg)
+		case MsgWithdrawTaxExemption:
+			return handleMsgWithdrawTaxExemption(ctx, k, msg)
+		case MsgVoteTaxExemption:
+			return handleMsgVoteTaxExemption(ctx, k, msg)
+
+		default:
+			errMsg := "Unrecognized treasury Msg type: " + reflect.TypeOf(msg).Name()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// handleMsgSubmitTaxExemption handles the logic of a MsgSubmitTaxExemption
+func handleMsgSubmitTaxExemption(ctx sdk.Context, k Keeper, msg MsgSubmitTaxExemption) sdk.Result {
+
+	// Burn the deposit from the submitter balance
+	depositErr := k.PayExemptionDeposit(ctx, msg.Submitter)
+	if depositErr != nil {
+		return depositErr.Result()
+	}
+
+	exemptionID := k.NewExemptionID(ctx)
+	exemption := NewTaxExemption(
+		exemptionID,
+		msg.Title,
+		msg.Description,
+		msg.Addresses,
+		msg.Submitter,
+		ctx.BlockHeight(),
+		ctx.BlockHeight()+k.GetParams(ctx).ExemptionVotePeriod,
+	)
+
+	k.StoreTaxExemption(ctx, exemption)
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.ExemptionID, strconv.FormatUint(exemptionID, 10),
+		),
+	}
+}
+
+// handleMsgWithdrawTaxExemption handles the logic of a MsgWithdrawTaxExemption
+func handleMsgWithdrawTaxExemption(ctx sdk.Context, k Keeper, msg MsgWithdrawTaxExemption) sdk.Result {
+	exemption, err := k.GetTaxExemption(ctx, msg.ExemptionID)
+	if err != nil {
+		return err.Result()
+	}
+
+	// Only submitters can withdraw the exemption
+	if !exemption.Submitter.Equals(msg.Submitter) {
+		return ErrInvalidSubmitter(DefaultCodespace, msg.Submitter).Result()
+	}
+
+	// Refund the deposit if the exemption never passed a tally
+	if !exemption.Active {
+		refundErr := k.RefundExemptionDeposit(ctx, exemption.Submitter)
+		if refundErr != nil {
+			return ErrExemptionRefundFailed(DefaultCodespace, msg.Submitter, msg.ExemptionID).Result()
+		}
+	}
+
+	k.DeleteTaxExemption(ctx, msg.ExemptionID)
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.ExemptionID, strconv.FormatUint(msg.ExemptionID, 10),
+		),
+	}
+}
+
+// handleMsgVoteTaxExemption handles the logic of a MsgVoteTaxExemption
+func handleMsgVoteTaxExemption(ctx sdk.Context, k Keeper, msg MsgVoteTaxExemption) sdk.Result {
+	_, err := k.GetTaxExemption(ctx, msg.ExemptionID)
+	if err != nil {
+		return err.Result()
+	}
+
+	// Check the voter is a validator
+	val := k.valset.Validator(ctx, sdk.ValAddress(msg.Voter))
+	if val == nil {
+		return staking.ErrNoValidatorFound(DefaultCodespace).Result()
+	}
+
+	k.AddExemptionVote(ctx, msg.ExemptionID, msg.Voter, msg.Option)
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			tags.ExemptionID, strconv.FormatUint(msg.ExemptionID, 10),
+			tags.Voter, msg.Voter.String(),
+			tags.Option, strconv.FormatBool(msg.Option),
+		),
+	}
+}
@@ -0,0 +1,84 @@
+package treasury
+
+import (
+	"testing"
+
+	"github.com/terra-project/core/types/assets"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func submitTestExemption(t *testing.T, input testInput, h sdk.Handler) {
+	deposit := input.treasuryKeeper.GetParams(input.ctx).ExemptionDeposit
+	err := input.mintKeeper.Mint(input.ctx, addrs[0], deposit)
+	require.NoError(t, err)
+
+	msg := NewMsgSubmitTaxExemption("test", "testdescription", []sdk.AccAddress{addrs[0], addrs[1]}, addrs[0])
+	res := h(input.ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestHandlerMsgSubmitTaxExemption(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.treasuryKeeper)
+
+	// Submitting without the deposit fails
+	msg := NewMsgSubmitTaxExemption("test", "testdescription", []sdk.AccAddress{addrs[0], addrs[1]}, addrs[0])
+	res := h(input.ctx, msg)
+	require.False(t, res.IsOK())
+
+	submitTestExemption(t, input, h)
+	require.True(t, input.bankKeeper.GetCoins(input.ctx, addrs[0]).AmountOf(assets.MicroSDRDenom).IsZero())
+
+	exemption, err := input.treasuryKeeper.GetTaxExemption(input.ctx, 1)
+	require.Nil(t, err)
+	require.False(t, exemption.Active)
+	require.False(t, input.treasuryKeeper.IsExemptTransfer(input.ctx, addrs[0], []sdk.AccAddress{addrs[1]}))
+}
+
+func TestHandlerMsgWithdrawTaxExemption(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.treasuryKeeper)
+	submitTestExemption(t, input, h)
+
+	// Withdrawing from a different submitter address doesn't work
+	res := h(input.ctx, NewMsgWithdrawTaxExemption(1, addrs[2]))
+	require.False(t, res.IsOK())
+
+	// Withdrawing a pending exemption refunds the deposit
+	res = h(input.ctx, NewMsgWithdrawTaxExemption(1, addrs[0]))
+	require.True(t, res.IsOK())
+
+	deposit := input.treasuryKeeper.GetParams(input.ctx).ExemptionDeposit
+	require.Equal(t, deposit.Amount, input.bankKeeper.GetCoins(input.ctx, addrs[0]).AmountOf(assets.MicroSDRDenom))
+
+	// Withdrawing again doesn't work
+	res = h(input.ctx, NewMsgWithdrawTaxExemption(1, addrs[0]))
+	require.False(t, res.IsOK())
+}
+
+func TestHandlerMsgVoteTaxExemption(t *testing.T) {
+	input := createTestInput(t)
+
+	h := NewHandler(input.treasuryKeeper)
+	submitTestExemption(t, input, h)
+
+	// Validators can vote on a submitted exemption
+	res := h(input.ctx, NewMsgVoteTaxExemption(1, true, addrs[0]))
+	require.True(t, res.IsOK())
+
+	// Non-validators cannot vote
+	nonValidator := sdk.AccAddress([]byte("nonvalidatoraddress1"))
+	res = h(input.ctx, NewMsgVoteTaxExemption(1, true, nonValidator))
+	require.False(t, res.IsOK())
+	require.Equal(t, staking.ErrNoValidatorFound(DefaultCodespace).Result().Code, res.Code)
+
+	// Voting on an unsubmitted exemption doesn't work
+	res = h(input.ctx, NewMsgVoteTaxExemption(4, true, addrs[0]))
+	require.False(t, res.IsOK())
+}
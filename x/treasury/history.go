@@ -0,0 +1,123 @@
+package treasury
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochTaxRate - struct to store the tax rate of an epoch
+type EpochTaxRate struct {
+	Epoch   sdk.Int `json:"epoch"`
+	TaxRate sdk.Dec `json:"tax_rate"`
+}
+
+// NewEpochTaxRate creates an EpochTaxRate instance
+func NewEpochTaxRate(epoch sdk.Int, taxRate sdk.Dec) EpochTaxRate {
+	return EpochTaxRate{
+		Epoch:   epoch,
+		TaxRate: taxRate,
+	}
+}
+
+// String implements fmt.Stringer
+func (r EpochTaxRate) String() string {
+	return fmt.Sprintf(`EpochTaxRate
+	Epoch:   %s
+	TaxRate: %s`,
+		r.Epoch, r.TaxRate)
+}
+
+// EpochDenomTaxRate - struct to store the tax rate of a denom in an epoch
+type EpochDenomTaxRate struct {
+	Epoch   sdk.Int `json:"epoch"`
+	Denom   string  `json:"denom"`
+	TaxRate sdk.Dec `json:"tax_rate"`
+}
+
+// NewEpochDenomTaxRate creates an EpochDenomTaxRate instance
+func NewEpochDenomTaxRate(epoch sdk.Int, denom string, taxRate sdk.Dec) EpochDenomTaxRate {
+	return EpochDenomTaxRate{
+		Epoch:   epoch,
+		Denom:   denom,
+		TaxRate: taxRate,
+	}
+}
+
+// String implements fmt.Stringer
+func (r EpochDenomTaxRate) String() string {
+	return fmt.Sprintf(`EpochDenomTaxRate
+	Epoch:   %s
+	Denom:   %s
+	TaxRate: %s`,
+		r.Epoch, r.Denom, r.TaxRate)
+}
+
+// EpochRewardWeight - struct to store the mining reward weight of an epoch
+type EpochRewardWeight struct {
+	Epoch        sdk.Int `json:"epoch"`
+	RewardWeight sdk.Dec `json:"reward_weight"`
+}
+
+// NewEpochRewardWeight creates an EpochRewardWeight instance
+func NewEpochRewardWeight(epoch sdk.Int, rewardWeight sdk.Dec) EpochRewardWeight {
+	return EpochRewardWeight{
+		Epoch:        epoch,
+		RewardWeight: rewardWeight,
+	}
+}
+
+// String implements fmt.Stringer
+func (w EpochRewardWeight) String() string {
+	return fmt.Sprintf(`EpochRewardWeight
+	Epoch:        %s
+	RewardWeight: %s`,
+		w.Epoch, w.RewardWeight)
+}
+
+// EpochTaxProceeds - struct to store the tax proceeds collected in an epoch
+type EpochTaxProceeds struct {
+	Epoch       sdk.Int   `json:"epoch"`
+	TaxProceeds sdk.Coins `json:"tax_proceeds"`
+}
+
+// NewEpochTaxProceeds creates an EpochTaxProceeds instance
+func NewEpochTaxProceeds(epoch sdk.Int, taxProceeds sdk.Coins) EpochTaxProceeds {
+	return EpochTaxProceeds{
+		Epoch:       epoch,
+		TaxProceeds: taxProceeds,
+	}
+}
+
+// String implements fmt.Stringer
+func (p EpochTaxProceeds) String() string {
+	return fmt.Sprintf(`EpochTaxProceeds
+	Epoch:       %s
+	TaxProceeds: %s`,
+		p.Epoch, p.TaxProceeds)
+}
+
+// EpochTaxCap - struct to store the tax cap of a denom in an epoch
+type EpochTaxCap struct {
+	Epoch  sdk.Int `json:"epoch"`
+	Denom  string  `json:"denom"`
+	TaxCap sdk.Int `json:"tax_cap"`
+}
+
+// NewEpochTaxCap creates an EpochTaxCap instance
+func NewEpochTaxCap(epoch sdk.Int, denom string, taxCap sdk.Int) EpochTaxCap {
+	return EpochTaxCap{
+		Epoch:  epoch,
+		Denom:  denom,
+		TaxCap: taxCap,
+	}
+}
+
+// String implements fmt.Stringer
+func (c EpochTaxCap) String() string {
+	return fmt.Sprintf(`EpochTaxCap
+	Epoch:  %s
+	Denom:  %s
+	TaxCap: %s`,
+		c.Epoch, c.Denom, c.TaxCap)
+}
@@ -1,8 +1,10 @@
 package treasury
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/terra-project/core/types/assets"
-	"github.com/terra-project/core/types/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -38,9 +40,16 @@ func TaxRewardsForEpoch(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
 	return taxRewardInMicroSDR
 }
 
+// DenomTaxRewardsForEpoch returns an indicator of the tax rewards collected in {denom}, in units of {denom}
+func DenomTaxRewardsForEpoch(denom string) func(sdk.Context, Keeper, sdk.Int) sdk.Dec {
+	return func(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
+		return sdk.NewDecFromInt(k.PeekTaxProceeds(ctx, epoch).AmountOf(denom))
+	}
+}
+
 // SeigniorageRewardsForEpoch returns seigniorage rewards for the epoch
 func SeigniorageRewardsForEpoch(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
-	seignioragePool := k.mtk.PeekEpochSeigniorage(ctx, epoch)
+	seignioragePool := k.GetEpochSeigniorage(ctx, epoch)
 	rewardAmt := k.GetRewardWeight(ctx, epoch).MulInt(seignioragePool)
 	seigniorageReward := sdk.NewDecCoinFromDec(assets.MicroLunaDenom, rewardAmt)
 
@@ -65,6 +74,13 @@ func TRL(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
 	return UnitLunaIndicator(ctx, k, epoch, TaxRewardsForEpoch)
 }
 
+// DenomTRL returns an indicator of the tax rewards in {denom} / luna / epoch
+func DenomTRL(denom string) func(sdk.Context, Keeper, sdk.Int) sdk.Dec {
+	return func(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
+		return UnitLunaIndicator(ctx, k, epoch, DenomTaxRewardsForEpoch(denom))
+	}
+}
+
 // SRL returns Seigniorage rewards / luna / epoch
 func SRL(ctx sdk.Context, k Keeper, epoch sdk.Int) sdk.Dec {
 	return UnitLunaIndicator(ctx, k, epoch, SeigniorageRewardsForEpoch)
@@ -90,7 +106,7 @@ func SumIndicator(ctx sdk.Context, k Keeper, epochs sdk.Int,
 	indicatorFunction func(sdk.Context, Keeper, sdk.Int) sdk.Dec) sdk.Dec {
 	sum := sdk.ZeroDec()
 	var i sdk.Int
-	curEpoch := util.GetEpoch(ctx)
+	curEpoch := k.ck.GetEpoch(ctx)
 	for i = curEpoch; i.GTE(sdk.ZeroInt()) && i.GT(curEpoch.Sub(epochs)); i = i.Sub(sdk.OneInt()) {
 		val := indicatorFunction(ctx, k, i)
 		sum = sum.Add(val)
@@ -105,7 +121,7 @@ func RollingAverageIndicator(ctx sdk.Context, k Keeper, epochs sdk.Int,
 	indicatorFunction func(sdk.Context, Keeper, sdk.Int) sdk.Dec) sdk.Dec {
 	sum := sdk.ZeroDec()
 	var i sdk.Int
-	curEpoch := util.GetEpoch(ctx)
+	curEpoch := k.ck.GetEpoch(ctx)
 	for i = curEpoch; i.GTE(sdk.ZeroInt()) && i.GT(curEpoch.Sub(epochs)); i = i.Sub(sdk.OneInt()) {
 		val := indicatorFunction(ctx, k, i)
 		sum = sum.Add(val)
@@ -118,3 +134,67 @@ func RollingAverageIndicator(ctx sdk.Context, k Keeper, epochs sdk.Int,
 
 	return sum.QuoInt(computedEpochs)
 }
+
+// IndicatorValue - value of an indicator at an epoch, with its rolling averages and sums over the
+// WindowShort and WindowLong epochs ending at that epoch
+type IndicatorValue struct {
+	Epoch        sdk.Int `json:"epoch"`
+	Value        sdk.Dec `json:"value"`
+	ShortAverage sdk.Dec `json:"short_average"`
+	LongAverage  sdk.Dec `json:"long_average"`
+	ShortSum     sdk.Dec `json:"short_sum"`
+	LongSum      sdk.Dec `json:"long_sum"`
+}
+
+// NewIndicatorValue creates an IndicatorValue instance
+func NewIndicatorValue(epoch sdk.Int, value, shortAverage, longAverage, shortSum, longSum sdk.Dec) IndicatorValue {
+	return IndicatorValue{
+		Epoch:        epoch,
+		Value:        value,
+		ShortAverage: shortAverage,
+		LongAverage:  longAverage,
+		ShortSum:     shortSum,
+		LongSum:      longSum,
+	}
+}
+
+// String implements fmt.Stringer
+func (v IndicatorValue) String() string {
+	return fmt.Sprintf(`IndicatorValue
+	Epoch:        %s
+	Value:        %s
+	ShortAverage: %s
+	LongAverage:  %s
+	ShortSum:     %s
+	LongSum:      %s`,
+		v.Epoch, v.Value, v.ShortAverage, v.LongAverage, v.ShortSum, v.LongSum)
+}
+
+// IndicatorValues is a collection of IndicatorValue
+type IndicatorValues []IndicatorValue
+
+// String implements fmt.Stringer
+func (vs IndicatorValues) String() (out string) {
+	for _, val := range vs {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// EvaluateIndicator evaluates the indicator at {epoch} and its rolling averages and sums with the indicator
+// functions the EndBlocker uses. Past epochs are evaluated at the current bonded Luna supply and
+// oracle prices, so their values may differ from what the EndBlocker of that epoch computed.
+func EvaluateIndicator(ctx sdk.Context, k Keeper, epoch sdk.Int,
+	indicatorFunction func(sdk.Context, Keeper, sdk.Int) sdk.Dec) IndicatorValue {
+	params := k.GetParams(ctx)
+	epochCtx := ctx.WithBlockHeight(k.ck.EpochFirstBlock(ctx, epoch))
+
+	return NewIndicatorValue(
+		epoch,
+		indicatorFunction(epochCtx, k, epoch),
+		RollingAverageIndicator(epochCtx, k, params.WindowShort, indicatorFunction),
+		RollingAverageIndicator(epochCtx, k, params.WindowLong, indicatorFunction),
+		SumIndicator(epochCtx, k, params.WindowShort, indicatorFunction),
+		SumIndicator(epochCtx, k, params.WindowLong, indicatorFunction),
+	)
+}
@@ -31,7 +31,7 @@ func TestFeeRewardsForEpoch(t *testing.T) {
 	})
 
 	// Get taxes
-	taxProceedsInSDR := TaxRewardsForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
+	taxProceedsInSDR := TaxRewardsForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
 	require.Equal(t, sdk.NewDec(1111).MulInt64(assets.MicroUnit), taxProceedsInSDR)
 }
 
@@ -43,7 +43,7 @@ func TestSeigniorageRewardsForEpoch(t *testing.T) {
 
 	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sAmt))
 
-	SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
+	SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
 
 	// Set random prices
 	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroSDRDenom, lnasdrRate)
@@ -54,8 +54,8 @@ func TestSeigniorageRewardsForEpoch(t *testing.T) {
 	input.mintKeeper.Burn(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sAmt))
 
 	// Get seigniorage rewards
-	seigniorageProceeds := SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
-	miningRewardWeight := input.treasuryKeeper.GetRewardWeight(input.ctx, util.GetEpoch(input.ctx))
+	seigniorageProceeds := SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
+	miningRewardWeight := input.treasuryKeeper.GetRewardWeight(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
 	require.Equal(t, lnasdrRate.MulInt(sAmt).Mul(miningRewardWeight), seigniorageProceeds)
 }
 
@@ -81,9 +81,9 @@ func TestMiningRewardsForEpoch(t *testing.T) {
 	// Add seigniorage
 	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, amt))
 
-	tProceeds := TaxRewardsForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
-	sProceeds := SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
-	mProceeds := MiningRewardForEpoch(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx))
+	tProceeds := TaxRewardsForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
+	sProceeds := SeigniorageRewardsForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
+	mProceeds := MiningRewardForEpoch(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, tProceeds.Add(sProceeds), mProceeds)
 }
@@ -95,7 +95,7 @@ func TestUnitIndicator(t *testing.T) {
 
 	// Just get an indicator to multiply the unit value by the expected rval.
 	// the unit indicator function obviously should return the expected rval.
-	actual := UnitLunaIndicator(input.ctx, input.treasuryKeeper, util.GetEpoch(input.ctx),
+	actual := UnitLunaIndicator(input.ctx, input.treasuryKeeper, input.calendarKeeper.GetEpoch(input.ctx),
 		func(_ sdk.Context, _ Keeper, _ sdk.Int) sdk.Dec {
 			return sdk.NewDecFromInt(lunaTotalBondedAmount.MulRaw(20))
 		})
@@ -0,0 +1,38 @@
+package treasury
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all treasury invariants
+func RegisterInvariants(c CrisisKeeper, k Keeper) {
+	c.RegisterRoute(ModuleName, "policy-bounds", PolicyBoundsInvariant(k))
+}
+
+// PolicyBoundsInvariant checks that the tax rate and the reward weight of the current epoch
+// lie within the bounds of their policy constraints
+func PolicyBoundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		// The getters lazily store the rate of the epoch; keep the invariant free of side effects
+		ctx, _ = ctx.CacheContext()
+
+		params := k.GetParams(ctx)
+		epoch := k.ck.GetEpoch(ctx)
+
+		taxRate := k.GetTaxRate(ctx, epoch)
+		if taxRate.LT(params.TaxPolicy.RateMin) || taxRate.GT(params.TaxPolicy.RateMax) {
+			return fmt.Errorf("tax rate %s of epoch %s is out of bounds [%s, %s]",
+				taxRate, epoch, params.TaxPolicy.RateMin, params.TaxPolicy.RateMax)
+		}
+
+		rewardWeight := k.GetRewardWeight(ctx, epoch)
+		if rewardWeight.LT(params.RewardPolicy.RateMin) || rewardWeight.GT(params.RewardPolicy.RateMax) {
+			return fmt.Errorf("reward weight %s of epoch %s is out of bounds [%s, %s]",
+				rewardWeight, epoch, params.RewardPolicy.RateMin, params.RewardPolicy.RateMax)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package treasury
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyBoundsInvariant(t *testing.T) {
+	input := createTestInput(t)
+	invariant := PolicyBoundsInvariant(input.treasuryKeeper)
+	params := input.treasuryKeeper.GetParams(input.ctx)
+
+	require.Nil(t, invariant(input.ctx))
+
+	// Tax rate above the policy maximum
+	input.treasuryKeeper.SetTaxRate(input.ctx, params.TaxPolicy.RateMax.Add(params.TaxPolicy.ChangeRateMax))
+	require.NotNil(t, invariant(input.ctx))
+
+	// Reward weight below the policy minimum
+	input.treasuryKeeper.SetTaxRate(input.ctx, params.TaxPolicy.RateMin)
+	require.Nil(t, invariant(input.ctx))
+
+	input.treasuryKeeper.SetRewardWeight(input.ctx, params.RewardPolicy.RateMin.Sub(params.RewardPolicy.ChangeRateMax))
+	require.NotNil(t, invariant(input.ctx))
+}
@@ -1,7 +1,10 @@
 package treasury
 
 import (
-	"github.com/terra-project/core/types/util"
+	"strconv"
+	"strings"
+
+	"github.com/terra-project/core/types/assets"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,19 +20,26 @@ type Keeper struct {
 
 	mtk MintKeeper
 	mk  MarketKeeper
+	ok  OracleKeeper
+	dk  DistributionKeeper
+	ck  CalendarKeeper
 
 	paramSpace params.Subspace
 }
 
 // NewKeeper constructs a new keeper
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, valset sdk.ValidatorSet,
-	mtk MintKeeper, mk MarketKeeper, paramspace params.Subspace) Keeper {
+	mtk MintKeeper, mk MarketKeeper, ok OracleKeeper, dk DistributionKeeper, ck CalendarKeeper,
+	paramspace params.Subspace) Keeper {
 	return Keeper{
 		cdc:        cdc,
 		key:        key,
 		valset:     valset,
 		mtk:        mtk,
 		mk:         mk,
+		ok:         ok,
+		dk:         dk,
+		ck:         ck,
 		paramSpace: paramspace.WithKeyTable(paramKeyTable()),
 	}
 }
@@ -40,9 +50,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, valset sdk.ValidatorSet,
 // SetRewardWeight sets the ratio of the treasury that goes to mining rewards, i.e.
 // supply of Luna that is burned. You can only set the reward weight of the current epoch.
 func (k Keeper) SetRewardWeight(ctx sdk.Context, weight sdk.Dec) {
-	store := ctx.KVStore(k.key)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(weight)
-	store.Set(keyRewardWeight(util.GetEpoch(ctx)), bz)
+	k.setRewardWeightAt(ctx, k.ck.GetEpoch(ctx), weight)
 }
 
 // GetRewardWeight returns the mining reward weight
@@ -92,9 +100,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 
 // SetTaxRate sets the tax rate; called from the treasury.
 func (k Keeper) SetTaxRate(ctx sdk.Context, rate sdk.Dec) {
-	store := ctx.KVStore(k.key)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rate)
-	store.Set(keyTaxRate(util.GetEpoch(ctx)), bz)
+	k.setTaxRateAt(ctx, k.ck.GetEpoch(ctx), rate)
 }
 
 // GetTaxRate gets the tax rate
@@ -118,32 +124,133 @@ func (k Keeper) GetTaxRate(ctx sdk.Context, epoch sdk.Int) (rate sdk.Dec) {
 	return
 }
 
-// setTaxCap sets the Tax Cap. Denominated in integer units of the reference {denom}
-func (k Keeper) setTaxCap(ctx sdk.Context, denom string, cap sdk.Int) {
+// setDenomTaxRate sets the tax rate of {denom} for {epoch}
+func (k Keeper) setDenomTaxRate(ctx sdk.Context, denom string, epoch sdk.Int, rate sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rate)
+	store.Set(keyDenomTaxRate(denom, epoch), bz)
+}
+
+// GetDenomTaxRate gets the tax rate charged on {denom} at {epoch}. Denoms that are not listed in
+// TaxRateDenoms pay the single tax rate. A listed denom falls back to the latest rate recorded
+// before the epoch, and starts from the single tax rate when it has none.
+func (k Keeper) GetDenomTaxRate(ctx sdk.Context, denom string, epoch sdk.Int) (rate sdk.Dec) {
+	if !k.GetParams(ctx).hasTaxRateDenom(denom) {
+		return k.GetTaxRate(ctx, epoch)
+	}
+
+	store := ctx.KVStore(k.key)
+
+	oldestEpoch := k.GetOldestEpoch(ctx)
+	for e := epoch; e.GTE(oldestEpoch); e = e.Sub(sdk.OneInt()) {
+		if bz := store.Get(keyDenomTaxRate(denom, e)); bz != nil {
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rate)
+			return
+		}
+	}
+
+	return k.GetTaxRate(ctx, epoch)
+}
+
+// setTaxCap sets the Tax Cap of {denom} for {epoch}. Denominated in integer units of the reference {denom}
+func (k Keeper) setTaxCap(ctx sdk.Context, denom string, epoch sdk.Int, cap sdk.Int) {
 	store := ctx.KVStore(k.key)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cap)
-	store.Set(keyTaxCap(denom), bz)
+	store.Set(keyTaxCap(denom, epoch), bz)
 }
 
-// GetTaxCap gets the Tax Cap. Denominated in integer units of the reference {denom}
-func (k Keeper) GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int) {
+// GetTaxCap gets the Tax Cap of {denom} at {epoch}. Denominated in integer units of the reference {denom}.
+// Falls back to the latest cap recorded before the epoch; a denom without any recorded cap
+// gets one computed from the policy cap. Caps are only recorded by the EndBlocker and genesis,
+// so that the lookup stays read-only in the AnteHandler. Epochs after the current one resolve
+// to the current cap.
+func (k Keeper) GetTaxCap(ctx sdk.Context, denom string, epoch sdk.Int) (taxCap sdk.Int) {
 	store := ctx.KVStore(k.key)
 
-	if bz := store.Get(keyTaxCap(denom)); bz != nil {
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &taxCap)
-	} else {
-		// Tax cap does not exist for the asset; compute it by
-		// comparing it with the tax cap for TerraSDR
-		referenceCap := k.GetParams(ctx).TaxPolicy.Cap
-		reqCap, _, err := k.mk.GetSwapCoin(ctx, referenceCap, denom, true)
+	if curEpoch := k.ck.GetEpoch(ctx); epoch.GT(curEpoch) {
+		epoch = curEpoch
+	}
 
-		// The coin is more valuable than TaxPolicy asset. just follow the Policy Cap.
-		if err != nil {
-			reqCap = sdk.NewCoin(denom, referenceCap.Amount)
+	oldestEpoch := k.GetOldestEpoch(ctx)
+	for e := epoch; e.GTE(oldestEpoch); e = e.Sub(sdk.OneInt()) {
+		if bz := store.Get(keyTaxCap(denom, e)); bz != nil {
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &taxCap)
+			return
 		}
+	}
 
-		taxCap = reqCap.Amount
-		k.setTaxCap(ctx, denom, taxCap)
+	// Tax cap does not exist for the asset; compute it by
+	// comparing it with the tax cap for TerraSDR
+	referenceCap := k.GetParams(ctx).TaxPolicy.Cap
+	return k.computeTaxCap(ctx, denom, referenceCap.Amount)
+}
+
+// computeTaxCap converts the policy cap into {denom} at the current oracle price,
+// returning {fallback} when the denom has no price.
+func (k Keeper) computeTaxCap(ctx sdk.Context, denom string, fallback sdk.Int) sdk.Int {
+	referenceCap := k.GetParams(ctx).TaxPolicy.Cap
+	if denom == referenceCap.Denom {
+		return referenceCap.Amount
+	}
+
+	reqCap, _, err := k.mk.GetSwapCoin(ctx, referenceCap, denom, true)
+	if err != nil {
+		return fallback
+	}
+
+	return reqCap.Amount
+}
+
+// IterateTaxCaps iterates over the tax caps recorded for {epoch}
+func (k Keeper) IterateTaxCaps(ctx sdk.Context, epoch sdk.Int, handler func(denom string, taxCap sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	prefix := prefixTaxCapEpoch(epoch)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := strings.TrimPrefix(string(iter.Key()), string(prefix))
+
+		var taxCap sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &taxCap)
+		if handler(denom, taxCap) {
+			break
+		}
+	}
+}
+
+// ComputeTax returns the stability tax due on a transfer of {principal} from {sender} to {recipients}.
+// Transfers among the addresses of an active tax exemption are not taxed.
+func (k Keeper) ComputeTax(ctx sdk.Context, sender sdk.AccAddress, recipients []sdk.AccAddress, principal sdk.Coins) (taxes sdk.Coins) {
+	if k.IsExemptTransfer(ctx, sender, recipients) {
+		return nil
+	}
+
+	return k.EstimateTax(ctx, principal)
+}
+
+// EstimateTax returns the stability tax due on {principal} under the rates and caps of the current epoch:
+// min(principal * taxRate(denom), taxCap(denom)) for every coin.
+func (k Keeper) EstimateTax(ctx sdk.Context, principal sdk.Coins) (taxes sdk.Coins) {
+	epoch := k.ck.GetEpoch(ctx)
+	for _, coin := range principal {
+		taxRate := k.GetDenomTaxRate(ctx, coin.Denom, epoch)
+		if taxRate.Equal(sdk.ZeroDec()) {
+			continue
+		}
+
+		taxDue := sdk.NewDecFromInt(coin.Amount).Mul(taxRate).TruncateInt()
+
+		// If tax due is greater than the tax cap, cap!
+		taxCap := k.GetTaxCap(ctx, coin.Denom, epoch)
+		if taxDue.GT(taxCap) {
+			taxDue = taxCap
+		}
+
+		if taxDue.Equal(sdk.ZeroInt()) {
+			continue
+		}
+
+		taxes = taxes.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, taxDue))).Sort()
 	}
 
 	return
@@ -151,13 +258,11 @@ func (k Keeper) GetTaxCap(ctx sdk.Context, denom string) (taxCap sdk.Int) {
 
 // RecordTaxProceeds add tax proceeds that have been added this epoch
 func (k Keeper) RecordTaxProceeds(ctx sdk.Context, delta sdk.Coins) {
-	epoch := util.GetEpoch(ctx)
+	epoch := k.ck.GetEpoch(ctx)
 	proceeds := k.PeekTaxProceeds(ctx, epoch)
 	proceeds = proceeds.Add(delta)
 
-	store := ctx.KVStore(k.key)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(proceeds)
-	store.Set(keyTaxProceeds(epoch), bz)
+	k.setTaxProceeds(ctx, epoch, proceeds)
 }
 
 // PeekTaxProceeds peeks the total amount of taxes that have been collected in the given epoch.
@@ -171,3 +276,433 @@ func (k Keeper) PeekTaxProceeds(ctx sdk.Context, epoch sdk.Int) (res sdk.Coins)
 	}
 	return
 }
+
+//-----------------------------------
+// Seigniorage allocation logic
+
+// allocateSeigniorage splits the seigniorage of the current epoch between mining rewards, the oracle
+// swap fee pool, budget programs, the distribution community pool and the burn, and records the split.
+// Mining rewards and the burn need no further action as the seigniorage was burned by swaps already;
+// budget programs are paid from the record by the budget EndBlocker, which records what it paid.
+func (k Keeper) allocateSeigniorage(ctx sdk.Context) (allocation SeigniorageAllocation, err sdk.Error) {
+	epoch := k.ck.GetEpoch(ctx)
+	seigniorage := k.mtk.PeekEpochSeigniorage(ctx, epoch)
+	allocation = splitSeigniorage(epoch, seigniorage, k.GetRewardWeight(ctx, epoch), k.GetParams(ctx))
+
+	if allocation.OracleRewards.IsPositive() {
+		k.ok.AddSwapFeePool(ctx, sdk.NewCoins(sdk.NewCoin(assets.MicroLunaDenom, allocation.OracleRewards)))
+
+		// Coins in the swap fee pool are part of the issuance
+		err = k.mtk.ChangeIssuance(ctx, assets.MicroLunaDenom, allocation.OracleRewards)
+		if err != nil {
+			return
+		}
+	}
+
+	if allocation.CommunityPool.IsPositive() {
+		communityPool := sdk.NewCoins(sdk.NewCoin(assets.MicroLunaDenom, allocation.CommunityPool))
+
+		feePool := k.dk.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(communityPool))
+		k.dk.SetFeePool(ctx, feePool)
+
+		// Coins in the community pool are part of the issuance, and its luna is tracked by the staking pool
+		err = k.mtk.ChangeIssuance(ctx, assets.MicroLunaDenom, allocation.CommunityPool)
+		if err != nil {
+			return
+		}
+		k.mtk.ChangeNotBondedTokens(ctx, allocation.CommunityPool)
+	}
+
+	k.SetSeigniorageAllocation(ctx, allocation)
+	return
+}
+
+// GetSeigniorageAllocation returns the allocation of the seigniorage of the epoch; nothing is
+// allocated before the last block of the epoch.
+func (k Keeper) GetSeigniorageAllocation(ctx sdk.Context, epoch sdk.Int) (allocation SeigniorageAllocation) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keySeigniorageAllocation(epoch))
+	if bz == nil {
+		return NewSeigniorageAllocation(epoch, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &allocation)
+	return
+}
+
+// GetEpochSeigniorage returns the seigniorage of the epoch. Once the epoch is allocated, the allocation
+// re-issued part of its seigniorage on the last day of the epoch, so the recorded total is read instead of
+// the issuance difference.
+func (k Keeper) GetEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) sdk.Int {
+	store := ctx.KVStore(k.key)
+	if !store.Has(keySeigniorageAllocation(epoch)) {
+		return k.mtk.PeekEpochSeigniorage(ctx, epoch)
+	}
+
+	return k.GetSeigniorageAllocation(ctx, epoch).Total()
+}
+
+// SetSeigniorageAllocation stores the allocation of the seigniorage of an epoch
+func (k Keeper) SetSeigniorageAllocation(ctx sdk.Context, allocation SeigniorageAllocation) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(allocation)
+	store.Set(keySeigniorageAllocation(allocation.Epoch), bz)
+}
+
+// IterateSeigniorageAllocations iterates over the seigniorage allocations of every stored epoch
+func (k Keeper) IterateSeigniorageAllocations(ctx sdk.Context, handler func(allocation SeigniorageAllocation) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixSeigniorageAllocation, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var allocation SeigniorageAllocation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &allocation)
+		return handler(allocation)
+	})
+}
+
+//-----------------------------------
+// History pruning logic
+
+// GetOldestEpoch returns the first epoch whose tax rate, reward weight and tax proceeds are still kept in the store
+func (k Keeper) GetOldestEpoch(ctx sdk.Context) (epoch sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(keyOldestEpoch)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &epoch)
+	return
+}
+
+// setOldestEpoch sets the first epoch whose history is still kept in the store
+func (k Keeper) setOldestEpoch(ctx sdk.Context, epoch sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(epoch)
+	store.Set(keyOldestEpoch, bz)
+}
+
+// ValidateEpoch returns an error if the history of {epoch} was pruned
+func (k Keeper) ValidateEpoch(ctx sdk.Context, epoch sdk.Int) sdk.Error {
+	if oldestEpoch := k.GetOldestEpoch(ctx); epoch.LT(oldestEpoch) {
+		return ErrHistoryPruned(DefaultCodespace, epoch, oldestEpoch)
+	}
+
+	return nil
+}
+
+// PruneHistory deletes the tax rates, reward weights, tax proceeds, tax caps and seigniorage allocations
+// of every epoch before {cutoffEpoch}.
+// The tax rates and reward weight of the cutoff epoch are stored first, so that later epochs
+// never fall back to a pruned epoch. Tax caps are recorded for every epoch by the EndBlocker.
+func (k Keeper) PruneHistory(ctx sdk.Context, cutoffEpoch sdk.Int) {
+	if cutoffEpoch.LTE(k.GetOldestEpoch(ctx)) {
+		return
+	}
+
+	k.GetTaxRate(ctx, cutoffEpoch)
+	k.GetRewardWeight(ctx, cutoffEpoch)
+	for _, denom := range k.GetParams(ctx).TaxRateDenoms {
+		k.setDenomTaxRate(ctx, denom, cutoffEpoch, k.GetDenomTaxRate(ctx, denom, cutoffEpoch))
+	}
+
+	store := ctx.KVStore(k.key)
+	for _, prefix := range [][]byte{prefixTaxRate, prefixDenomTaxRate, prefixRewardWeight, prefixTaxProceeds, prefixTaxCap, prefixSeigniorageAllocation} {
+		var prunedKeys [][]byte
+		k.iterateEpochRecords(ctx, prefix, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+			if epoch.LT(cutoffEpoch) {
+				prunedKeys = append(prunedKeys, key)
+			}
+			return false
+		})
+
+		for _, key := range prunedKeys {
+			store.Delete(key)
+		}
+	}
+
+	k.setOldestEpoch(ctx, cutoffEpoch)
+}
+
+//-----------------------------------
+// History iteration logic
+
+// iterateEpochRecords iterates over the per-epoch records stored under {prefix}.
+// Key format is {prefix}:{epoch}, or {prefix}:{epoch}:{denom} for per-denom records.
+func (k Keeper) iterateEpochRecords(ctx sdk.Context, prefix []byte,
+	handler func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		parts := strings.SplitN(strings.TrimPrefix(string(iter.Key()), string(prefix)+":"), ":", 2)
+
+		epoch, ok := sdk.NewIntFromString(parts[0])
+		if !ok {
+			continue
+		}
+
+		var denom string
+		if len(parts) == 2 {
+			denom = parts[1]
+		}
+
+		if handler(iter.Key(), epoch, denom, iter.Value()) {
+			break
+		}
+	}
+}
+
+// IterateTaxRates iterates over the tax rates of every stored epoch
+func (k Keeper) IterateTaxRates(ctx sdk.Context, handler func(epoch sdk.Int, taxRate sdk.Dec) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixTaxRate, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var taxRate sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &taxRate)
+		return handler(epoch, taxRate)
+	})
+}
+
+// IterateAllDenomTaxRates iterates over the tax rates of every denom listed in TaxRateDenoms and stored epoch
+func (k Keeper) IterateAllDenomTaxRates(ctx sdk.Context, handler func(epoch sdk.Int, denom string, taxRate sdk.Dec) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixDenomTaxRate, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var taxRate sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &taxRate)
+		return handler(epoch, denom, taxRate)
+	})
+}
+
+// IterateRewardWeights iterates over the reward weights of every stored epoch
+func (k Keeper) IterateRewardWeights(ctx sdk.Context, handler func(epoch sdk.Int, rewardWeight sdk.Dec) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixRewardWeight, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var rewardWeight sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &rewardWeight)
+		return handler(epoch, rewardWeight)
+	})
+}
+
+// IterateTaxProceeds iterates over the tax proceeds of every stored epoch
+func (k Keeper) IterateTaxProceeds(ctx sdk.Context, handler func(epoch sdk.Int, taxProceeds sdk.Coins) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixTaxProceeds, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var taxProceeds sdk.Coins
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &taxProceeds)
+		return handler(epoch, taxProceeds)
+	})
+}
+
+// IterateAllTaxCaps iterates over the tax caps of every denom and stored epoch
+func (k Keeper) IterateAllTaxCaps(ctx sdk.Context, handler func(epoch sdk.Int, denom string, taxCap sdk.Int) (stop bool)) {
+	k.iterateEpochRecords(ctx, prefixTaxCap, func(key []byte, epoch sdk.Int, denom string, value []byte) (stop bool) {
+		var taxCap sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &taxCap)
+		return handler(epoch, denom, taxCap)
+	})
+}
+
+// setTaxRateAt sets the tax rate of {epoch}
+func (k Keeper) setTaxRateAt(ctx sdk.Context, epoch sdk.Int, rate sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rate)
+	store.Set(keyTaxRate(epoch), bz)
+}
+
+// setRewardWeightAt sets the reward weight of {epoch}
+func (k Keeper) setRewardWeightAt(ctx sdk.Context, epoch sdk.Int, weight sdk.Dec) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(weight)
+	store.Set(keyRewardWeight(epoch), bz)
+}
+
+// setTaxProceeds sets the tax proceeds of {epoch}
+func (k Keeper) setTaxProceeds(ctx sdk.Context, epoch sdk.Int, proceeds sdk.Coins) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(proceeds)
+	store.Set(keyTaxProceeds(epoch), bz)
+}
+
+//-----------------------------------
+// Tax exemption logic
+
+// NewExemptionID generates a new tax exemption id; advances sequentially from 1
+func (k Keeper) NewExemptionID(ctx sdk.Context) (exemptionID uint64) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyNextExemptionID); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &exemptionID)
+		exemptionID++
+	} else {
+		exemptionID = 1
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(exemptionID)
+	store.Set(keyNextExemptionID, bz)
+	return
+}
+
+func (k Keeper) setLastExemptionID(ctx sdk.Context, exemptionID uint64) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(exemptionID)
+	store.Set(keyNextExemptionID, bz)
+}
+
+// GetTaxExemption gets the tax exemption with the given id from the store
+func (k Keeper) GetTaxExemption(ctx sdk.Context, exemptionID uint64) (res TaxExemption, err sdk.Error) {
+	store := ctx.KVStore(k.key)
+	if bz := store.Get(keyTaxExemption(exemptionID)); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	} else {
+		err = ErrExemptionNotFound(DefaultCodespace, exemptionID)
+	}
+	return
+}
+
+// StoreTaxExemption sets a tax exemption to the store; the addresses of an active
+// exemption are indexed so that ComputeTax can look them up.
+func (k Keeper) StoreTaxExemption(ctx sdk.Context, exemption TaxExemption) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(exemption)
+	store.Set(keyTaxExemption(exemption.ExemptionID), bz)
+
+	if exemption.Active {
+		for _, addr := range exemption.Addresses {
+			store.Set(keyExemptedAddress(addr, exemption.ExemptionID), []byte{1})
+		}
+	}
+}
+
+// DeleteTaxExemption deletes a tax exemption, its address index and its votes from the store
+func (k Keeper) DeleteTaxExemption(ctx sdk.Context, exemptionID uint64) {
+	exemption, err := k.GetTaxExemption(ctx, exemptionID)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.key)
+	for _, addr := range exemption.Addresses {
+		store.Delete(keyExemptedAddress(addr, exemptionID))
+	}
+
+	k.DeleteVotesForExemption(ctx, exemptionID)
+	store.Delete(keyTaxExemption(exemptionID))
+}
+
+// IterateTaxExemptions iterates over the tax exemptions in the store
+func (k Keeper) IterateTaxExemptions(ctx sdk.Context, handler func(TaxExemption) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixTaxExemption)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var exemption TaxExemption
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &exemption)
+
+		if handler(exemption) {
+			break
+		}
+	}
+}
+
+// IsExemptTransfer returns true if {sender} and every one of {recipients} belong to the same active tax exemption
+func (k Keeper) IsExemptTransfer(ctx sdk.Context, sender sdk.AccAddress, recipients []sdk.AccAddress) (exempt bool) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixExemptedAddressSets(sender))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		elems := strings.Split(string(iter.Key()), ":")
+		exemptionID, err := strconv.ParseUint(elems[2], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		exempt = true
+		for _, recipient := range recipients {
+			if !store.Has(keyExemptedAddress(recipient, exemptionID)) {
+				exempt = false
+				break
+			}
+		}
+
+		if exempt {
+			return
+		}
+	}
+
+	return false
+}
+
+// AddExemptionVote adds the vote option of {voter} on a tax exemption to the store
+func (k Keeper) AddExemptionVote(ctx sdk.Context, exemptionID uint64, voter sdk.AccAddress, option bool) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(option)
+	store.Set(keyExemptionVote(exemptionID, voter), bz)
+}
+
+// DeleteExemptionVote deletes the vote of {voter} on a tax exemption from the store
+func (k Keeper) DeleteExemptionVote(ctx sdk.Context, exemptionID uint64, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(keyExemptionVote(exemptionID, voter))
+}
+
+// DeleteVotesForExemption deletes all votes on a tax exemption from the store
+func (k Keeper) DeleteVotesForExemption(ctx sdk.Context, exemptionID uint64) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefixExemptionVotes(exemptionID))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
+
+// IterateExemptionVotes iterates over the votes on a tax exemption
+func (k Keeper) IterateExemptionVotes(ctx sdk.Context, exemptionID uint64, handler func(voter sdk.AccAddress, option bool) (stop bool)) {
+	k.iterateExemptionVotesWithPrefix(ctx, prefixExemptionVotes(exemptionID), func(_ uint64, voter sdk.AccAddress, option bool) (stop bool) {
+		return handler(voter, option)
+	})
+}
+
+// IterateAllExemptionVotes iterates over the votes on every tax exemption
+func (k Keeper) IterateAllExemptionVotes(ctx sdk.Context, handler func(exemptionID uint64, voter sdk.AccAddress, option bool) (stop bool)) {
+	k.iterateExemptionVotesWithPrefix(ctx, prefixExemptionVote, handler)
+}
+
+func (k Keeper) iterateExemptionVotesWithPrefix(ctx sdk.Context, prefix []byte,
+	handler func(exemptionID uint64, voter sdk.AccAddress, option bool) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var option bool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &option)
+
+		elems := strings.Split(string(iter.Key()), ":")
+		exemptionID, err := strconv.ParseUint(elems[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		voter, err := sdk.AccAddressFromBech32(elems[2])
+		if err != nil {
+			continue
+		}
+
+		if handler(exemptionID, voter, option) {
+			break
+		}
+	}
+}
+
+// PayExemptionDeposit burns the tax exemption deposit from the submitter's balance
+func (k Keeper) PayExemptionDeposit(ctx sdk.Context, submitter sdk.AccAddress) sdk.Error {
+	deposit := k.GetParams(ctx).ExemptionDeposit
+	if deposit.Amount.IsZero() {
+		return nil
+	}
+
+	return k.mtk.Burn(ctx, submitter, deposit)
+}
+
+// RefundExemptionDeposit mints the tax exemption deposit back to the submitter
+func (k Keeper) RefundExemptionDeposit(ctx sdk.Context, submitter sdk.AccAddress) sdk.Error {
+	deposit := k.GetParams(ctx).ExemptionDeposit
+	if deposit.Amount.IsZero() {
+		return nil
+	}
+
+	return k.mtk.Mint(ctx, submitter, deposit)
+}
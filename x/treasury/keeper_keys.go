@@ -13,16 +13,30 @@ var (
 	PrefixClaim         = []byte("claim")
 	paramStoreKeyParams = []byte("params")
 
-	prefixTaxRate     = []byte("tax_rate")
-	prefixTaxProceeds = []byte("tax_proceeds")
-	prefixTaxCap      = []byte("tax_cap")
-	prefixIssuance    = []byte("issuance")
+	prefixTaxRate      = []byte("tax_rate")
+	prefixDenomTaxRate = []byte("denom_tax_rate")
+	prefixTaxProceeds  = []byte("tax_proceeds")
+	prefixTaxCap       = []byte("tax_cap")
+	prefixIssuance     = []byte("issuance")
+
+	prefixSeigniorageAllocation = []byte("seigniorage_allocation")
+
+	keyOldestEpoch = []byte("oldest_epoch")
+
+	keyNextExemptionID    = []byte("next_exemption_id")
+	prefixTaxExemption    = []byte("tax_exemption")
+	prefixExemptionVote   = []byte("exemption_vote")
+	prefixExemptedAddress = []byte("exempt_address")
 )
 
 func keyTaxRate(epoch sdk.Int) []byte {
 	return []byte(fmt.Sprintf("%s:%s", prefixTaxRate, epoch))
 }
 
+func keyDenomTaxRate(denom string, epoch sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s", prefixDenomTaxRate, epoch, denom))
+}
+
 func keyRewardWeight(epoch sdk.Int) []byte {
 	return []byte(fmt.Sprintf("%s:%s", prefixRewardWeight, epoch))
 }
@@ -35,8 +49,36 @@ func keyTaxProceeds(epoch sdk.Int) []byte {
 	return []byte(fmt.Sprintf("%s:%s", prefixTaxProceeds, epoch))
 }
 
-func keyTaxCap(denom string) []byte {
-	return []byte(fmt.Sprintf("%s:%s", prefixTaxCap, denom))
+func keyTaxCap(denom string, epoch sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%s", prefixTaxCap, epoch, denom))
+}
+
+func keySeigniorageAllocation(epoch sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s", prefixSeigniorageAllocation, epoch))
+}
+
+func prefixTaxCapEpoch(epoch sdk.Int) []byte {
+	return []byte(fmt.Sprintf("%s:%s:", prefixTaxCap, epoch))
+}
+
+func keyTaxExemption(exemptionID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%d", prefixTaxExemption, exemptionID))
+}
+
+func keyExemptionVote(exemptionID uint64, voter sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s:%d:%s", prefixExemptionVote, exemptionID, voter))
+}
+
+func prefixExemptionVotes(exemptionID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%d:", prefixExemptionVote, exemptionID))
+}
+
+func keyExemptedAddress(addr sdk.AccAddress, exemptionID uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%d", prefixExemptedAddress, addr, exemptionID))
+}
+
+func prefixExemptedAddressSets(addr sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s:%s:", prefixExemptedAddress, addr))
 }
 
 func paramKeyTable() params.KeyTable {
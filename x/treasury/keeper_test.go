@@ -5,6 +5,7 @@ import (
 
 	"github.com/terra-project/core/types/assets"
 	"github.com/terra-project/core/types/util"
+	"github.com/terra-project/core/x/mint"
 
 	"github.com/stretchr/testify/require"
 
@@ -35,7 +36,7 @@ func TestTax(t *testing.T) {
 	// Set & get tax rate
 	testRate := sdk.NewDecWithPrec(2, 3)
 	input.treasuryKeeper.SetTaxRate(input.ctx, testRate)
-	curRate := input.treasuryKeeper.GetTaxRate(input.ctx, util.GetEpoch(input.ctx))
+	curRate := input.treasuryKeeper.GetTaxRate(input.ctx, input.calendarKeeper.GetEpoch(input.ctx))
 	require.Equal(t, curRate, testRate)
 
 	// Vicariously set tax caps & test
@@ -47,15 +48,96 @@ func TestTax(t *testing.T) {
 	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroCNYDenom, sdk.NewDec(10))
 	input.oracleKeeper.SetLunaSwapRate(input.ctx, assets.MicroKRWDenom, sdk.NewDec(100))
 
-	readSdrCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroSDRDenom)
-	cnyCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroCNYDenom)
-	krwCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom)
+	readSdrCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroSDRDenom, input.calendarKeeper.GetEpoch(input.ctx))
+	cnyCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroCNYDenom, input.calendarKeeper.GetEpoch(input.ctx))
+	krwCap := input.treasuryKeeper.GetTaxCap(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, sdrCap.Amount, readSdrCap)
 	require.Equal(t, sdrCap.Amount.MulRaw(10), cnyCap)
 	require.Equal(t, sdrCap.Amount.MulRaw(100), krwCap)
 }
 
+func TestDenomTaxRate(t *testing.T) {
+	input := createTestInput(t)
+
+	taxRate := sdk.NewDecWithPrec(1, 3)
+	input.treasuryKeeper.SetTaxRate(input.ctx, taxRate)
+
+	// Without per-denom rates every denom pays the single rate
+	require.Equal(t, taxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.TaxRateDenoms = []string{assets.MicroKRWDenom}
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	// A listed denom starts from the single rate
+	require.Equal(t, taxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+
+	krwTaxRate := sdk.NewDecWithPrec(5, 3)
+	input.treasuryKeeper.setDenomTaxRate(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx), krwTaxRate)
+	require.Equal(t, krwTaxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+	require.Equal(t, taxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroSDRDenom, input.calendarKeeper.GetEpoch(input.ctx)))
+
+	// Later epochs fall back to the latest recorded rate
+	require.Equal(t, krwTaxRate, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, sdk.NewInt(3)))
+
+	// Taxes are charged at the rate of each denom
+	taxes := input.treasuryKeeper.EstimateTax(input.ctx, sdk.NewCoins(
+		sdk.NewInt64Coin(assets.MicroKRWDenom, 100000),
+		sdk.NewInt64Coin(assets.MicroSDRDenom, 100000),
+	))
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(assets.MicroKRWDenom, 500),
+		sdk.NewInt64Coin(assets.MicroSDRDenom, 100),
+	), taxes)
+}
+
+func TestSeigniorageAllocation(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.OracleSeigniorageShare = sdk.NewDecWithPrec(2, 1)
+	params.BudgetSeigniorageShare = sdk.NewDecWithPrec(5, 1)
+	params.CommunityPoolSeigniorageShare = sdk.NewDecWithPrec(2, 1)
+	params.BurnSeigniorageShare = sdk.NewDecWithPrec(1, 1)
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	// Swaps burn 10001 Luna during the epoch
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch - 1)
+	err := input.mintKeeper.Burn(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(10001)))
+	require.Nil(t, err)
+
+	issuance := input.mintKeeper.GetIssuance(input.ctx, assets.MicroLunaDenom, input.calendarKeeper.GetDay(input.ctx))
+
+	allocation, err := input.treasuryKeeper.allocateSeigniorage(input.ctx)
+	require.Nil(t, err)
+
+	// 5% reward weight for miners; rounding dust is burned
+	epoch := input.calendarKeeper.GetEpoch(input.ctx)
+	require.Equal(t, NewSeigniorageAllocation(epoch, sdk.NewInt(500), sdk.NewInt(1900), sdk.NewInt(4750), sdk.NewInt(1900), sdk.NewInt(951)), allocation)
+	require.Equal(t, allocation, input.treasuryKeeper.GetSeigniorageAllocation(input.ctx, epoch))
+
+	oracleRewards := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 1900))
+	communityPool := sdk.NewCoins(sdk.NewInt64Coin(assets.MicroLunaDenom, 1900))
+	require.Equal(t, oracleRewards, input.oracleKeeper.GetSwapFeePool(input.ctx))
+	require.Equal(t, sdk.NewDecCoins(communityPool), input.distrKeeper.GetFeePool(input.ctx).CommunityPool)
+
+	// The oracle and community pool shares are issued right away
+	require.Equal(t, issuance.AddRaw(3800), input.mintKeeper.GetIssuance(input.ctx, assets.MicroLunaDenom, input.calendarKeeper.GetDay(input.ctx)))
+
+	// The issuance still matches the held supply
+	invariant := mint.SupplyInvariant(input.mintKeeper, input.feeKeeper, input.distrKeeper, input.oracleKeeper)
+	require.Nil(t, invariant(input.ctx))
+
+	// The seigniorage of the epoch is read from the allocation, not from the issuance it raised
+	require.Equal(t, sdk.NewInt(6201), input.mintKeeper.PeekEpochSeigniorage(input.ctx, epoch))
+	require.Equal(t, sdk.NewInt(10001), input.treasuryKeeper.GetEpochSeigniorage(input.ctx, epoch))
+
+	nextEpochCtx := input.ctx.WithBlockHeight(util.BlocksPerEpoch * 2)
+	require.Equal(t, sdk.NewInt(10001), input.treasuryKeeper.GetEpochSeigniorage(nextEpochCtx, epoch))
+}
+
 func TestParams(t *testing.T) {
 	input := createTestInput(t)
 
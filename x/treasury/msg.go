@@ -0,0 +1,188 @@
+package treasury
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSubmitTaxExemption defines a message to propose a set of addresses exempted from the stability tax
+type MsgSubmitTaxExemption struct {
+	Title       string           `json:"title"`       // Title of the exemption
+	Description string           `json:"description"` // Description of the exemption
+	Addresses   []sdk.AccAddress `json:"addresses"`   // Addresses exempted from taxes among each other
+	Submitter   sdk.AccAddress   `json:"submitter"`   // Address of the submitter
+}
+
+// NewMsgSubmitTaxExemption creates a MsgSubmitTaxExemption instance
+func NewMsgSubmitTaxExemption(title string, description string,
+	addresses []sdk.AccAddress, submitter sdk.AccAddress) MsgSubmitTaxExemption {
+	return MsgSubmitTaxExemption{
+		Title:       title,
+		Description: description,
+		Addresses:   addresses,
+		Submitter:   submitter,
+	}
+}
+
+// Route returns msg route
+func (msg MsgSubmitTaxExemption) Route() string { return RouterKey }
+
+// Type returns msg type
+func (msg MsgSubmitTaxExemption) Type() string { return "submittaxexemption" }
+
+// GetSignBytes returns sign bytes
+func (msg MsgSubmitTaxExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns signer
+func (msg MsgSubmitTaxExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// ValidateBasic validate msg
+func (msg MsgSubmitTaxExemption) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Submitter.String())
+	}
+	if len(strings.TrimSpace(msg.Title)) <= 0 {
+		return ErrInvalidTitle(DefaultCodespace)
+	}
+	if len(strings.TrimSpace(msg.Description)) <= 0 {
+		return ErrInvalidDescription(DefaultCodespace)
+	}
+	if len(msg.Addresses) < 2 {
+		return ErrInvalidExemptionSet(DefaultCodespace, "at least two addresses are required")
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range msg.Addresses {
+		if len(addr) == 0 {
+			return sdk.ErrInvalidAddress("Invalid address: " + addr.String())
+		}
+		if seen[addr.String()] {
+			return ErrInvalidExemptionSet(DefaultCodespace, "duplicate address "+addr.String())
+		}
+		seen[addr.String()] = true
+	}
+
+	return nil
+}
+
+// String stringify the msg
+func (msg MsgSubmitTaxExemption) String() string {
+	return fmt.Sprintf(`MsgSubmitTaxExemption
+	Title: %v
+	Addresses: %v
+	Submitter: %v`, msg.Title, msg.Addresses, msg.Submitter)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgWithdrawTaxExemption defines a message to withdraw a tax exemption
+type MsgWithdrawTaxExemption struct {
+	ExemptionID uint64         `json:"exemption_id"` // ID of the exemption
+	Submitter   sdk.AccAddress `json:"submitter"`    // Address of the submitter
+}
+
+// NewMsgWithdrawTaxExemption creates a MsgWithdrawTaxExemption instance
+func NewMsgWithdrawTaxExemption(exemptionID uint64, submitter sdk.AccAddress) MsgWithdrawTaxExemption {
+	return MsgWithdrawTaxExemption{
+		ExemptionID: exemptionID,
+		Submitter:   submitter,
+	}
+}
+
+// Route returns msg route
+func (msg MsgWithdrawTaxExemption) Route() string { return RouterKey }
+
+// Type returns msg type
+func (msg MsgWithdrawTaxExemption) Type() string { return "withdrawtaxexemption" }
+
+// GetSignBytes returns sign bytes
+func (msg MsgWithdrawTaxExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns signer
+func (msg MsgWithdrawTaxExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// ValidateBasic validate msg
+func (msg MsgWithdrawTaxExemption) ValidateBasic() sdk.Error {
+	if len(msg.Submitter) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Submitter.String())
+	}
+	if msg.ExemptionID == 0 {
+		return ErrInvalidExemptionID(DefaultCodespace, msg.ExemptionID)
+	}
+
+	return nil
+}
+
+// String stringify the msg
+func (msg MsgWithdrawTaxExemption) String() string {
+	return fmt.Sprintf(`MsgWithdrawTaxExemption
+	ExemptionID: %v
+	Submitter: %v`, msg.ExemptionID, msg.Submitter)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgVoteTaxExemption defines the msg of a validator containing the vote option on
+// a specific tax exemption
+type MsgVoteTaxExemption struct {
+	ExemptionID uint64         `json:"exemption_id"` // ID of the exemption
+	Option      bool           `json:"option"`       // Option chosen by voter
+	Voter       sdk.AccAddress `json:"voter"`        // Address of the voter
+}
+
+// NewMsgVoteTaxExemption creates a MsgVoteTaxExemption instance
+func NewMsgVoteTaxExemption(exemptionID uint64, option bool, voter sdk.AccAddress) MsgVoteTaxExemption {
+	return MsgVoteTaxExemption{
+		ExemptionID: exemptionID,
+		Option:      option,
+		Voter:       voter,
+	}
+}
+
+// Route returns msg route
+func (msg MsgVoteTaxExemption) Route() string { return RouterKey }
+
+// Type returns msg type
+func (msg MsgVoteTaxExemption) Type() string { return "votetaxexemption" }
+
+// GetSignBytes returns sign bytes
+func (msg MsgVoteTaxExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns signer
+func (msg MsgVoteTaxExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// ValidateBasic validate msg
+func (msg MsgVoteTaxExemption) ValidateBasic() sdk.Error {
+	if len(msg.Voter) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Voter.String())
+	}
+	if msg.ExemptionID == 0 {
+		return ErrInvalidExemptionID(DefaultCodespace, msg.ExemptionID)
+	}
+
+	return nil
+}
+
+// String stringify the msg
+func (msg MsgVoteTaxExemption) String() string {
+	return fmt.Sprintf(`MsgVoteTaxExemption
+	ExemptionID: %v
+	Voter: %v
+	Option: %v`, msg.ExemptionID, msg.Voter, msg.Option)
+}
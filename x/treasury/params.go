@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/types/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,6 +20,22 @@ type Params struct {
 	WindowShort     sdk.Int `json:"window_short"`
 	WindowLong      sdk.Int `json:"window_long"`
 	WindowProbation sdk.Int `json:"window_probation"`
+
+	HistoryRetention sdk.Int `json:"history_retention"` // number of past epochs of tax rates, reward weights and tax proceeds kept in the store; 0 keeps every epoch
+
+	ExemptionVotePeriod int64    `json:"exemption_vote_period"` // number of blocks between two tallies of the tax exemption votes
+	ExemptionThreshold  sdk.Dec  `json:"exemption_threshold"`   // share of the bonded tokens a tax exemption needs in net yes votes to stay in force
+	ExemptionDeposit    sdk.Coin `json:"exemption_deposit"`     // deposit burned when a tax exemption is submitted
+
+	TaxRateDenoms []string `json:"tax_rate_denoms"` // denoms whose tax rate is adjusted on their own tax proceeds; other denoms pay the single tax rate
+
+	TaxController    ControllerParams `json:"tax_controller"`    // controller steering the tax rates
+	RewardController ControllerParams `json:"reward_controller"` // controller steering the reward weight
+
+	OracleSeigniorageShare        sdk.Dec `json:"oracle_seigniorage_share"`         // fraction of the seigniorage left after mining rewards added to the oracle swap fee pool
+	BudgetSeigniorageShare        sdk.Dec `json:"budget_seigniorage_share"`         // fraction of the seigniorage left after mining rewards granted to budget programs
+	CommunityPoolSeigniorageShare sdk.Dec `json:"community_pool_seigniorage_share"` // fraction of the seigniorage left after mining rewards sent to the distribution community pool
+	BurnSeigniorageShare          sdk.Dec `json:"burn_seigniorage_share"`           // fraction of the seigniorage left after mining rewards burned outright
 }
 
 // NewParams creates a new param instance
@@ -27,6 +44,11 @@ func NewParams(
 	seigniorageBurden sdk.Dec,
 	miningIncrement sdk.Dec,
 	windowShort, windowLong, windowProbation sdk.Int,
+	historyRetention sdk.Int,
+	exemptionVotePeriod int64, exemptionThreshold sdk.Dec, exemptionDeposit sdk.Coin,
+	taxRateDenoms []string,
+	taxController, rewardController ControllerParams,
+	oracleSeigniorageShare, budgetSeigniorageShare, communityPoolSeigniorageShare, burnSeigniorageShare sdk.Dec,
 ) Params {
 	return Params{
 		TaxPolicy:               taxPolicy,
@@ -36,6 +58,18 @@ func NewParams(
 		WindowShort:             windowShort,
 		WindowLong:              windowLong,
 		WindowProbation:         windowProbation,
+		HistoryRetention:        historyRetention,
+		ExemptionVotePeriod:     exemptionVotePeriod,
+		ExemptionThreshold:      exemptionThreshold,
+		ExemptionDeposit:        exemptionDeposit,
+		TaxRateDenoms:           taxRateDenoms,
+		TaxController:           taxController,
+		RewardController:        rewardController,
+
+		OracleSeigniorageShare:        oracleSeigniorageShare,
+		BudgetSeigniorageShare:        budgetSeigniorageShare,
+		CommunityPoolSeigniorageShare: communityPoolSeigniorageShare,
+		BurnSeigniorageShare:          burnSeigniorageShare,
 	}
 }
 
@@ -65,6 +99,23 @@ func DefaultParams() Params {
 		sdk.NewInt(4),
 		sdk.NewInt(52),
 		sdk.NewInt(12),
+
+		sdk.NewInt(52), // keep the epochs read by the long window
+
+		util.BlocksPerWeek,        // tally tax exemption votes weekly
+		sdk.NewDecWithPrec(33, 2), // 33%
+		sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt(100).MulRaw(assets.MicroUnit)), // 100 SDR
+
+		[]string{}, // every denom pays the single tax rate
+
+		DefaultControllerParams(),
+		DefaultControllerParams(),
+
+		// Seigniorage allocation; all of it goes to budget programs
+		sdk.ZeroDec(),
+		sdk.OneDec(),
+		sdk.ZeroDec(),
+		sdk.ZeroDec(),
 	)
 }
 
@@ -87,6 +138,88 @@ func validateParams(params Params) error {
 		return fmt.Errorf("treasury parameter RewardPolicy.RateMin must be >= 0, is %s", params.RewardPolicy.RateMin.String())
 	}
 
+	if params.HistoryRetention.IsNegative() {
+		return fmt.Errorf("treasury parameter HistoryRetention must be >= 0, is %s", params.HistoryRetention.String())
+	}
+
+	// Past controller observations read WindowLong epochs back from each of the Window past epochs
+	controllerWindow := params.TaxController.window()
+	if rewardWindow := params.RewardController.window(); rewardWindow > controllerWindow {
+		controllerWindow = rewardWindow
+	}
+
+	minRetention := params.WindowLong.AddRaw(controllerWindow)
+	if !params.HistoryRetention.IsZero() && params.HistoryRetention.LT(minRetention) {
+		return fmt.Errorf("treasury HistoryRetention %s must not be shorter than WindowLong %s plus the controller Window %d",
+			params.HistoryRetention.String(), params.WindowLong.String(), controllerWindow)
+	}
+
+	if params.ExemptionVotePeriod <= 0 {
+		return fmt.Errorf("treasury parameter ExemptionVotePeriod must be > 0, is %d", params.ExemptionVotePeriod)
+	}
+
+	if params.ExemptionThreshold.LTE(sdk.ZeroDec()) || params.ExemptionThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("treasury parameter ExemptionThreshold must be in (0, 1], is %s", params.ExemptionThreshold.String())
+	}
+
+	if params.ExemptionDeposit.Amount.IsNegative() {
+		return fmt.Errorf("treasury parameter ExemptionDeposit must be >= 0, is %s", params.ExemptionDeposit.String())
+	}
+
+	denomMap := make(map[string]bool)
+	for _, denom := range params.TaxRateDenoms {
+		if len(denom) == 0 || denomMap[denom] {
+			return fmt.Errorf("treasury parameter TaxRateDenoms must hold unique non-empty denoms, is %v", params.TaxRateDenoms)
+		}
+		denomMap[denom] = true
+	}
+
+	if err := validateControllerParams("TaxController", params.TaxController); err != nil {
+		return err
+	}
+
+	if err := validateControllerParams("RewardController", params.RewardController); err != nil {
+		return err
+	}
+
+	if params.OracleSeigniorageShare.IsNegative() || params.BudgetSeigniorageShare.IsNegative() ||
+		params.CommunityPoolSeigniorageShare.IsNegative() || params.BurnSeigniorageShare.IsNegative() {
+		return fmt.Errorf("treasury seigniorage shares must be >= 0, are %s, %s, %s, %s",
+			params.OracleSeigniorageShare, params.BudgetSeigniorageShare,
+			params.CommunityPoolSeigniorageShare, params.BurnSeigniorageShare)
+	}
+
+	shareSum := params.OracleSeigniorageShare.Add(params.BudgetSeigniorageShare).
+		Add(params.CommunityPoolSeigniorageShare).Add(params.BurnSeigniorageShare)
+	if !shareSum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("treasury seigniorage shares must sum up to 1, is %s", shareSum)
+	}
+
+	return nil
+}
+
+// MaxControllerWindow bounds the number of past epochs a PID controller observes
+const MaxControllerWindow = 52
+
+func validateControllerParams(name string, params ControllerParams) error {
+	switch params.Type {
+	case ControllerMultiplicative:
+		return nil
+	case ControllerPID:
+	default:
+		return fmt.Errorf("treasury parameter %s.Type must be %s or %s, is %s",
+			name, ControllerMultiplicative, ControllerPID, params.Type)
+	}
+
+	if params.Kp.IsNegative() || params.Ki.IsNegative() || params.Kd.IsNegative() {
+		return fmt.Errorf("treasury parameter %s gains must be >= 0, are Kp %s, Ki %s, Kd %s",
+			name, params.Kp, params.Ki, params.Kd)
+	}
+
+	if params.Window <= 0 || params.Window > MaxControllerWindow {
+		return fmt.Errorf("treasury parameter %s.Window must be in (0, %d], is %d", name, MaxControllerWindow, params.Window)
+	}
+
 	return nil
 }
 
@@ -101,6 +234,37 @@ func (params Params) String() string {
 
   WindowShort        : %v
   WindowLong         : %v
+
+  HistoryRetention   : %v
+
+  ExemptionVotePeriod : %v
+  ExemptionThreshold  : %v
+  ExemptionDeposit    : %v
+
+  TaxRateDenoms      : %v
+
+  TaxController      : { %v }
+  RewardController   : { %v }
+
+  OracleSeigniorageShare        : %v
+  BudgetSeigniorageShare        : %v
+  CommunityPoolSeigniorageShare : %v
+  BurnSeigniorageShare          : %v
   `, params.TaxPolicy, params.RewardPolicy, params.SeigniorageBurdenTarget,
-		params.MiningIncrement, params.WindowShort, params.WindowLong)
+		params.MiningIncrement, params.WindowShort, params.WindowLong, params.HistoryRetention,
+		params.ExemptionVotePeriod, params.ExemptionThreshold, params.ExemptionDeposit,
+		params.TaxRateDenoms, params.TaxController, params.RewardController,
+		params.OracleSeigniorageShare, params.BudgetSeigniorageShare,
+		params.CommunityPoolSeigniorageShare, params.BurnSeigniorageShare)
+}
+
+// hasTaxRateDenom returns true if {denom} has a tax rate of its own
+func (params Params) hasTaxRateDenom(denom string) bool {
+	for _, d := range params.TaxRateDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
 }
@@ -1,57 +1,379 @@
 package treasury
 
 import (
-	"github.com/terra-project/core/types/util"
+	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
-func updateTaxPolicy(ctx sdk.Context, k Keeper) (newTaxRate sdk.Dec) {
-	params := k.GetParams(ctx)
+// TaxRateUpdate - inputs and outcome of a tax rate update
+type TaxRateUpdate struct {
+	OldTaxRate sdk.Dec `json:"old_tax_rate"`
+	TLYear     sdk.Dec `json:"tl_year"`      // rolling average of the tax lift over WindowLong
+	TLMonth    sdk.Dec `json:"tl_month"`     // rolling average of the tax lift over WindowShort
+	RawTaxRate sdk.Dec `json:"raw_tax_rate"` // tax rate before the TaxPolicy constraints are applied
+	NewTaxRate sdk.Dec `json:"new_tax_rate"`
+}
 
-	oldTaxRate := k.GetTaxRate(ctx, util.GetEpoch(ctx))
-	inc := params.MiningIncrement
-	tlYear := RollingAverageIndicator(ctx, k, params.WindowLong, TRL)
-	tlMonth := RollingAverageIndicator(ctx, k, params.WindowShort, TRL)
+// String implements fmt.Stringer
+func (u TaxRateUpdate) String() string {
+	return fmt.Sprintf(`TaxRateUpdate
+	OldTaxRate: %s
+	TLYear:     %s
+	TLMonth:    %s
+	RawTaxRate: %s
+	NewTaxRate: %s`,
+		u.OldTaxRate, u.TLYear, u.TLMonth, u.RawTaxRate, u.NewTaxRate)
+}
+
+// RewardWeightUpdate - inputs and outcome of a reward weight update
+type RewardWeightUpdate struct {
+	OldRewardWeight   sdk.Dec `json:"old_reward_weight"`
+	SeigniorageSum    sdk.Dec `json:"seigniorage_sum"`    // seigniorage rewards over WindowShort
+	TotalSum          sdk.Dec `json:"total_sum"`          // mining rewards over WindowShort
+	SeigniorageBurden sdk.Dec `json:"seigniorage_burden"` // seigniorage rewards out of mining rewards; zero without revenues
+	RawRewardWeight   sdk.Dec `json:"raw_reward_weight"`  // reward weight before the RewardPolicy constraints are applied
+	NewRewardWeight   sdk.Dec `json:"new_reward_weight"`
+}
+
+// String implements fmt.Stringer
+func (u RewardWeightUpdate) String() string {
+	return fmt.Sprintf(`RewardWeightUpdate
+	OldRewardWeight:   %s
+	SeigniorageSum:    %s
+	TotalSum:          %s
+	SeigniorageBurden: %s
+	RawRewardWeight:   %s
+	NewRewardWeight:   %s`,
+		u.OldRewardWeight, u.SeigniorageSum, u.TotalSum, u.SeigniorageBurden, u.RawRewardWeight, u.NewRewardWeight)
+}
+
+// Controller types selectable in ControllerParams
+const (
+	ControllerMultiplicative = "multiplicative"
+	ControllerPID            = "pid"
+)
+
+// ControllerParams - selects the controller steering a policy variable, with its gains
+type ControllerParams struct {
+	Type   string  `json:"type"`   // ControllerMultiplicative or ControllerPID
+	Kp     sdk.Dec `json:"kp"`     // proportional gain of the PID controller
+	Ki     sdk.Dec `json:"ki"`     // integral gain of the PID controller
+	Kd     sdk.Dec `json:"kd"`     // derivative gain of the PID controller
+	Window int64   `json:"window"` // number of epochs, counting the current one, summed by the integral term of the PID controller
+}
+
+// NewControllerParams creates a ControllerParams instance
+func NewControllerParams(controllerType string, kp, ki, kd sdk.Dec, window int64) ControllerParams {
+	return ControllerParams{
+		Type:   controllerType,
+		Kp:     kp,
+		Ki:     ki,
+		Kd:     kd,
+		Window: window,
+	}
+}
+
+// DefaultControllerParams selects the multiplicative controller
+func DefaultControllerParams() ControllerParams {
+	return NewControllerParams(ControllerMultiplicative, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), 0)
+}
+
+// window returns the number of epochs the selected controller integrates over; 0 for the multiplicative controller
+func (params ControllerParams) window() int64 {
+	if params.Type != ControllerPID {
+		return 0
+	}
+
+	return params.Window
+}
+
+// String implements fmt.Stringer
+func (params ControllerParams) String() string {
+	return fmt.Sprintf("Type: %s, Kp: %s, Ki: %s, Kd: %s, Window: %d",
+		params.Type, params.Kp, params.Ki, params.Kd, params.Window)
+}
+
+// PolicyObservation - target and measured value, at the end of an epoch, of the indicator steered by a
+// policy variable
+type PolicyObservation struct {
+	Setpoint    sdk.Dec `json:"setpoint"`
+	Measurement sdk.Dec `json:"measurement"`
+}
+
+// NewPolicyObservation creates a PolicyObservation instance
+func NewPolicyObservation(setpoint, measurement sdk.Dec) PolicyObservation {
+	return PolicyObservation{
+		Setpoint:    setpoint,
+		Measurement: measurement,
+	}
+}
+
+// RelativeError returns (setpoint - measurement) / max(setpoint, measurement), in [-1, 1]; zero if
+// both are zero. The error is positive when the indicator falls short of its target.
+func (o PolicyObservation) RelativeError() sdk.Dec {
+	scale := o.Setpoint
+	if o.Measurement.GT(scale) {
+		scale = o.Measurement
+	}
+
+	if !scale.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return o.Setpoint.Sub(o.Measurement).Quo(scale)
+}
+
+// Controller computes the next value of a policy variable from the observations of the indicator it
+// steers. Raising the variable is expected to raise the indicator.
+type Controller interface {
+	// Lookback returns the number of epochs of observations NextRate reads, counting the current one
+	Lookback() int64
+
+	// NextRate returns the next value of the policy variable, before it is clamped by {constraints}.
+	// observations[i] is the observation of i epochs ago; epochs before genesis are left out.
+	NextRate(oldRate sdk.Dec, constraints PolicyConstraints, observations []PolicyObservation) sdk.Dec
+}
+
+// NewController returns the controller selected by {params}
+func NewController(params ControllerParams) Controller {
+	if params.Type == ControllerPID {
+		return NewPIDController(params.Kp, params.Ki, params.Kd, params.Window)
+	}
+
+	return MultiplicativeController{}
+}
+
+// MultiplicativeController scales the policy variable by the ratio of the setpoint to the measurement;
+// t(t+1) = t(t) * (TL_year(t) * INC) / TL_month(t) and w(t+1) = w(t) * SB_target / SB_rolling(t)
+type MultiplicativeController struct{}
+
+var _ Controller = MultiplicativeController{}
+
+// Lookback implements Controller
+func (c MultiplicativeController) Lookback() int64 {
+	return 1
+}
+
+// NextRate implements Controller
+func (c MultiplicativeController) NextRate(oldRate sdk.Dec, constraints PolicyConstraints, observations []PolicyObservation) sdk.Dec {
+	current := observations[0]
 
 	// No revenues, hike as much as possible.
-	if tlMonth.Equal(sdk.ZeroDec()) {
-		newTaxRate = params.TaxPolicy.RateMax
-	} else {
-		newTaxRate = oldTaxRate.Mul(tlYear.Mul(inc)).Quo(tlMonth)
+	if current.Measurement.IsZero() {
+		return constraints.RateMax
+	}
+
+	return oldRate.Mul(current.Setpoint).Quo(current.Measurement)
+}
+
+// PIDController moves the policy variable by the PID output on the relative error e of the observations;
+// r(t+1) = r(t) + Kp * e(t) + Ki * sum(e(t-Window+1) ... e(t)) + Kd * (e(t) - e(t-1))
+type PIDController struct {
+	Kp     sdk.Dec
+	Ki     sdk.Dec
+	Kd     sdk.Dec
+	Window int64
+}
+
+var _ Controller = PIDController{}
+
+// NewPIDController creates a PIDController instance
+func NewPIDController(kp, ki, kd sdk.Dec, window int64) PIDController {
+	return PIDController{
+		Kp:     kp,
+		Ki:     ki,
+		Kd:     kd,
+		Window: window,
+	}
+}
+
+// Lookback implements Controller; the derivative term reads the previous epoch
+func (c PIDController) Lookback() int64 {
+	if c.Window < 2 {
+		return 2
+	}
+
+	return c.Window
+}
+
+// NextRate implements Controller
+func (c PIDController) NextRate(oldRate sdk.Dec, _ PolicyConstraints, observations []PolicyObservation) sdk.Dec {
+	proportional := observations[0].RelativeError()
+
+	integral := sdk.ZeroDec()
+	for i := 0; i < len(observations) && int64(i) < c.Window; i++ {
+		integral = integral.Add(observations[i].RelativeError())
 	}
 
-	newTaxRate = params.TaxPolicy.Clamp(oldTaxRate, newTaxRate)
+	derivative := sdk.ZeroDec()
+	if len(observations) > 1 {
+		derivative = proportional.Sub(observations[1].RelativeError())
+	}
+
+	return oldRate.Add(c.Kp.Mul(proportional)).Add(c.Ki.Mul(integral)).Add(c.Kd.Mul(derivative))
+}
+
+// updateTaxPolicy sets the tax rate of the next epoch
+func updateTaxPolicy(ctx sdk.Context, k Keeper) (update TaxRateUpdate) {
+	oldTaxRate := k.GetTaxRate(ctx, k.ck.GetEpoch(ctx))
+	update = computeTaxRate(ctx, k, oldTaxRate, TRL)
 
 	// Set the new tax rate to the store
-	k.SetTaxRate(ctx.WithBlockHeight(ctx.BlockHeight()+1), newTaxRate)
+	k.SetTaxRate(ctx.WithBlockHeight(ctx.BlockHeight()+1), update.NewTaxRate)
 	return
 }
 
-// w(t+1) = w(t)*SB_target/SB_rolling(t)
-func updateRewardPolicy(ctx sdk.Context, k Keeper) (newRewardWeight sdk.Dec) {
+// updateDenomTaxPolicies applies the tax rate update of updateTaxPolicy to every denom listed in
+// TaxRateDenoms, with the tax lift computed from the tax proceeds collected in that denom
+func updateDenomTaxPolicies(ctx sdk.Context, k Keeper) (newTaxRates map[string]sdk.Dec) {
+	curEpoch := k.ck.GetEpoch(ctx)
+	nextEpoch := curEpoch.Add(sdk.OneInt())
+
+	newTaxRates = map[string]sdk.Dec{}
+	for _, denom := range k.GetParams(ctx).TaxRateDenoms {
+		oldTaxRate := k.GetDenomTaxRate(ctx, denom, curEpoch)
+		newTaxRates[denom] = computeTaxRate(ctx, k, oldTaxRate, DenomTRL(denom)).NewTaxRate
+
+		k.setDenomTaxRate(ctx, denom, nextEpoch, newTaxRates[denom])
+	}
+
+	return
+}
+
+// computeTaxRate returns the tax rate update following {oldTaxRate}, given the tax lift indicator {tl}.
+// The controller steers the tax lift of the short window towards the tax lift of the long window
+// grown by MiningIncrement.
+func computeTaxRate(ctx sdk.Context, k Keeper, oldTaxRate sdk.Dec,
+	tl func(sdk.Context, Keeper, sdk.Int) sdk.Dec) (update TaxRateUpdate) {
 	params := k.GetParams(ctx)
 
-	curEpoch := util.GetEpoch(ctx)
+	inc := params.MiningIncrement
+	update.OldTaxRate = oldTaxRate
+	update.TLYear = RollingAverageIndicator(ctx, k, params.WindowLong, tl)
+	update.TLMonth = RollingAverageIndicator(ctx, k, params.WindowShort, tl)
+
+	controller := NewController(params.TaxController)
+	observations := append([]PolicyObservation{NewPolicyObservation(update.TLYear.Mul(inc), update.TLMonth)},
+		pastObservations(ctx, k, controller, func(pastCtx sdk.Context) PolicyObservation {
+			return NewPolicyObservation(
+				RollingAverageIndicator(pastCtx, k, params.WindowLong, tl).Mul(inc),
+				RollingAverageIndicator(pastCtx, k, params.WindowShort, tl),
+			)
+		})...)
+
+	update.RawTaxRate = controller.NextRate(oldTaxRate, params.TaxPolicy, observations)
+	update.NewTaxRate = params.TaxPolicy.Clamp(oldTaxRate, update.RawTaxRate)
+	return
+}
+
+// updateRewardPolicy steers the seigniorage burden, the share of seigniorage rewards in the mining
+// rewards over WindowShort, towards SeigniorageBurdenTarget
+func updateRewardPolicy(ctx sdk.Context, k Keeper) (update RewardWeightUpdate) {
+	params := k.GetParams(ctx)
+
+	curEpoch := k.ck.GetEpoch(ctx)
 	oldWeight := k.GetRewardWeight(ctx, curEpoch)
 	sbTarget := params.SeigniorageBurdenTarget
 
-	seigniorageSum := SumIndicator(ctx, k, params.WindowShort, SeigniorageRewardsForEpoch)
-	totalSum := SumIndicator(ctx, k, params.WindowShort, MiningRewardForEpoch)
+	update.OldRewardWeight = oldWeight
+	update.SeigniorageSum = SumIndicator(ctx, k, params.WindowShort, SeigniorageRewardsForEpoch)
+	update.TotalSum = SumIndicator(ctx, k, params.WindowShort, MiningRewardForEpoch)
+	update.SeigniorageBurden = seigniorageBurden(update.SeigniorageSum, update.TotalSum)
 
-	// No revenues; hike as much as possible
-	if totalSum.Equal(sdk.ZeroDec()) || seigniorageSum.Equal(sdk.ZeroDec()) {
-		newRewardWeight = params.RewardPolicy.RateMax
-	} else {
-		// Seigniorage burden out of total rewards
-		sb := seigniorageSum.Quo(totalSum)
-		newRewardWeight = oldWeight.Mul(sbTarget.Quo(sb))
-	}
+	controller := NewController(params.RewardController)
+	observations := append([]PolicyObservation{NewPolicyObservation(sbTarget, update.SeigniorageBurden)},
+		pastObservations(ctx, k, controller, func(pastCtx sdk.Context) PolicyObservation {
+			return NewPolicyObservation(sbTarget, seigniorageBurden(
+				SumIndicator(pastCtx, k, params.WindowShort, SeigniorageRewardsForEpoch),
+				SumIndicator(pastCtx, k, params.WindowShort, MiningRewardForEpoch),
+			))
+		})...)
 
-	newRewardWeight = params.RewardPolicy.Clamp(oldWeight, newRewardWeight)
+	update.RawRewardWeight = controller.NextRate(oldWeight, params.RewardPolicy, observations)
+	update.NewRewardWeight = params.RewardPolicy.Clamp(oldWeight, update.RawRewardWeight)
 
 	// Set the new reward weight
-	k.SetRewardWeight(ctx.WithBlockHeight(ctx.BlockHeight()+1), newRewardWeight)
+	k.SetRewardWeight(ctx.WithBlockHeight(ctx.BlockHeight()+1), update.NewRewardWeight)
 	return
 }
+
+// seigniorageBurden returns the share of seigniorage rewards out of total rewards; zero without revenues
+func seigniorageBurden(seigniorageSum, totalSum sdk.Dec) sdk.Dec {
+	if totalSum.IsZero() || seigniorageSum.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	return seigniorageSum.Quo(totalSum)
+}
+
+// pastObservations evaluates {observe} at the last block of each past epoch {controller} looks back on,
+// starting with the previous epoch. Epochs before genesis are left out.
+func pastObservations(ctx sdk.Context, k Keeper, controller Controller,
+	observe func(sdk.Context) PolicyObservation) (observations []PolicyObservation) {
+	curEpoch := k.ck.GetEpoch(ctx)
+
+	for i := int64(1); i < controller.Lookback() && i <= curEpoch.Int64(); i++ {
+		lastBlock := k.ck.EpochLastBlock(ctx, curEpoch.SubRaw(i))
+		observations = append(observations, observe(ctx.WithBlockHeight(lastBlock)))
+	}
+
+	return
+}
+
+// projectPolicy runs the policy updates of the current epoch against a cached context, as the
+// EndBlocker would at the last block of the epoch with the data collected so far. Nothing is written
+// to the store.
+func projectPolicy(ctx sdk.Context, k Keeper) (probation bool, taxRate TaxRateUpdate,
+	denomTaxRates []EpochDenomTaxRate, rewardWeight RewardWeightUpdate) {
+	curEpoch := k.ck.GetEpoch(ctx)
+	lastBlock := k.ck.EpochLastBlock(ctx, curEpoch)
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithBlockHeight(lastBlock)
+
+	probation = isProbationPeriod(cacheCtx, k)
+	taxRate = updateTaxPolicy(cacheCtx, k)
+	newTaxRates := updateDenomTaxPolicies(cacheCtx, k)
+	rewardWeight = updateRewardPolicy(cacheCtx, k)
+
+	// In the order of TaxRateDenoms, as tagged by the EndBlocker
+	denomTaxRates = []EpochDenomTaxRate{}
+	for _, denom := range k.GetParams(ctx).TaxRateDenoms {
+		denomTaxRates = append(denomTaxRates, NewEpochDenomTaxRate(curEpoch.Add(sdk.OneInt()), denom, newTaxRates[denom]))
+	}
+
+	return
+}
+
+// updateTaxCaps recomputes the tax cap of every denom whitelisted by the oracle and of every denom with a cap
+// at the current epoch, for the next epoch, from the current oracle prices. A denom without a price keeps its cap
+// of the current epoch; a newly whitelisted denom falls back on the policy cap.
+func updateTaxCaps(ctx sdk.Context, k Keeper) {
+	curEpoch := k.ck.GetEpoch(ctx)
+	nextEpoch := curEpoch.Add(sdk.OneInt())
+
+	taxCaps := map[string]sdk.Int{}
+	k.IterateTaxCaps(ctx, curEpoch, func(denom string, taxCap sdk.Int) (stop bool) {
+		taxCaps[denom] = taxCap
+		return false
+	})
+
+	referenceCap := k.GetParams(ctx).TaxPolicy.Cap
+	for _, denom := range k.ok.GetActiveDenoms(ctx) {
+		if _, exists := taxCaps[denom]; !exists {
+			taxCaps[denom] = referenceCap.Amount
+		}
+	}
+
+	denoms := make([]string, 0, len(taxCaps))
+	for denom := range taxCaps {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		k.setTaxCap(ctx, denom, nextEpoch, k.computeTaxCap(ctx, denom, taxCaps[denom]))
+	}
+}
@@ -0,0 +1,203 @@
+package treasury
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/x/calendar"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+//
+// The policy simulator replays a series of observed epochs through the policy updates of the
+// EndBlocker, so that proposed treasury params can be evaluated against history.
+//
+// The treasury keeper runs on an in-memory store with the default calendar; the staking, mint and
+// market keepers it reads from are replaced by the observed bonded Luna, seigniorage and Luna price
+// of each epoch. The seigniorage is not allocated, so no oracle or distribution keeper is needed.
+//
+
+// SimulationEpoch - observed data of an epoch replayed by the policy simulator
+type SimulationEpoch struct {
+	TaxProceeds sdk.Int `json:"tax_proceeds"` // tax proceeds of the epoch in micro SDR
+	Seigniorage sdk.Int `json:"seigniorage"`  // seigniorage of the epoch in micro Luna
+	BondedLuna  sdk.Int `json:"bonded_luna"`  // bonded micro Luna during the epoch
+	LunaPrice   sdk.Dec `json:"luna_price"`   // price of Luna in SDR during the epoch
+}
+
+// NewSimulationEpoch creates a SimulationEpoch instance
+func NewSimulationEpoch(taxProceeds, seigniorage, bondedLuna sdk.Int, lunaPrice sdk.Dec) SimulationEpoch {
+	return SimulationEpoch{
+		TaxProceeds: taxProceeds,
+		Seigniorage: seigniorage,
+		BondedLuna:  bondedLuna,
+		LunaPrice:   lunaPrice,
+	}
+}
+
+// SimulatedPolicy - tax rate and reward weight in effect during an epoch of the simulation
+type SimulatedPolicy struct {
+	Epoch        sdk.Int `json:"epoch"`
+	TaxRate      sdk.Dec `json:"tax_rate"`
+	RewardWeight sdk.Dec `json:"reward_weight"`
+}
+
+// String implements fmt.Stringer
+func (p SimulatedPolicy) String() string {
+	return fmt.Sprintf("%s,%s,%s", p.Epoch, p.TaxRate, p.RewardWeight)
+}
+
+// SimulatedPolicies is a collection of SimulatedPolicy
+type SimulatedPolicies []SimulatedPolicy
+
+// String implements fmt.Stringer; policies are printed as CSV
+func (ps SimulatedPolicies) String() (out string) {
+	out = "epoch,tax_rate,reward_weight\n"
+	for _, p := range ps {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// SimulatePolicy replays {epochs}, starting at epoch 0 with {taxRate} and {rewardWeight}, through the
+// policy updates run by the EndBlocker at the end of every epoch. It returns the policy in effect
+// during every epoch, followed by the policy set for the epoch after the last one.
+func SimulatePolicy(simParams Params, taxRate, rewardWeight sdk.Dec, epochs []SimulationEpoch) (SimulatedPolicies, error) {
+	genesis := DefaultGenesisState()
+	genesis.Params = simParams
+	genesis.GenesisTaxRate = taxRate
+	genesis.GenesisRewardWeight = rewardWeight
+	if err := ValidateGenesis(genesis); err != nil {
+		return nil, err
+	}
+
+	for i, epoch := range epochs {
+		if epoch.TaxProceeds.IsNegative() || epoch.Seigniorage.IsNegative() || !epoch.BondedLuna.IsPositive() ||
+			!epoch.LunaPrice.IsPositive() {
+			return nil, fmt.Errorf("Invalid simulation epoch %d: tax proceeds and seigniorage must be >= 0, bonded luna and luna price must be > 0", i)
+		}
+	}
+
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyTreasury := sdk.NewKVStoreKey(StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
+	k := NewKeeper(cdc, keyTreasury,
+		simValidatorSet{ck: calendarKeeper, epochs: epochs},
+		simMintKeeper{epochs: epochs},
+		simMarketKeeper{ck: calendarKeeper, epochs: epochs},
+		nil, nil,
+		calendarKeeper,
+		paramsKeeper.Subspace(DefaultParamspace),
+	)
+
+	InitGenesis(ctx, k, genesis)
+
+	blocksPerEpoch := calendarKeeper.BlocksPerEpoch(ctx)
+
+	policies := SimulatedPolicies{}
+	for i := range epochs {
+		epoch := sdk.NewInt(int64(i))
+		ctx = ctx.WithBlockHeight(int64(i) * blocksPerEpoch)
+		k.setTaxProceeds(ctx, epoch, sdk.NewCoins(sdk.NewCoin(assets.MicroSDRDenom, epochs[i].TaxProceeds)))
+
+		policies = append(policies, SimulatedPolicy{
+			Epoch:        epoch,
+			TaxRate:      k.GetTaxRate(ctx, epoch),
+			RewardWeight: k.GetRewardWeight(ctx, epoch),
+		})
+
+		// Last block of the epoch
+		ctx = ctx.WithBlockHeight(int64(i+1)*blocksPerEpoch - 1)
+		if !isProbationPeriod(ctx, k) {
+			updateTaxPolicy(ctx, k)
+			updateRewardPolicy(ctx, k)
+		}
+	}
+
+	nextEpoch := sdk.NewInt(int64(len(epochs)))
+	policies = append(policies, SimulatedPolicy{
+		Epoch:        nextEpoch,
+		TaxRate:      k.GetTaxRate(ctx, nextEpoch),
+		RewardWeight: k.GetRewardWeight(ctx, nextEpoch),
+	})
+
+	return policies, nil
+}
+
+// simEpoch returns the observed data of the epoch of {ctx}
+func simEpoch(ctx sdk.Context, ck CalendarKeeper, epochs []SimulationEpoch) SimulationEpoch {
+	return epochs[ck.GetEpoch(ctx).Int64()]
+}
+
+// simValidatorSet reports the observed bonded Luna; the policy updates use no other method
+type simValidatorSet struct {
+	sdk.ValidatorSet
+	ck     CalendarKeeper
+	epochs []SimulationEpoch
+}
+
+func (vs simValidatorSet) TotalBondedTokens(ctx sdk.Context) sdk.Int {
+	return simEpoch(ctx, vs.ck, vs.epochs).BondedLuna
+}
+
+// simMintKeeper reports the observed seigniorage; the policy updates use no other method
+type simMintKeeper struct {
+	MintKeeper
+	epochs []SimulationEpoch
+}
+
+func (mk simMintKeeper) PeekEpochSeigniorage(ctx sdk.Context, epoch sdk.Int) sdk.Int {
+	if epoch.IsNegative() || epoch.GTE(sdk.NewInt(int64(len(mk.epochs)))) {
+		return sdk.ZeroInt()
+	}
+
+	return mk.epochs[epoch.Int64()].Seigniorage
+}
+
+// simMarketKeeper swaps Luna into SDR at the observed Luna price of the current epoch
+type simMarketKeeper struct {
+	ck     CalendarKeeper
+	epochs []SimulationEpoch
+}
+
+func (mk simMarketKeeper) GetSwapDecCoin(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, sdk.Error) {
+	if offerCoin.Denom != assets.MicroLunaDenom || askDenom != assets.MicroSDRDenom {
+		return sdk.DecCoin{}, sdk.ErrInternal(fmt.Sprintf("the policy simulator only swaps %s into %s", assets.MicroLunaDenom, assets.MicroSDRDenom))
+	}
+
+	return sdk.NewDecCoinFromDec(askDenom, offerCoin.Amount.Mul(simEpoch(ctx, mk.ck, mk.epochs).LunaPrice)), nil
+}
+
+func (mk simMarketKeeper) GetSwapCoin(ctx sdk.Context, offerCoin sdk.Coin, askDenom string, isInternal bool) (sdk.Coin, sdk.Dec, sdk.Error) {
+	return sdk.Coin{}, sdk.ZeroDec(), sdk.ErrInternal("the policy simulator does not swap coins")
+}
@@ -0,0 +1,112 @@
+package treasury
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSimulatePolicy(t *testing.T) {
+	params := DefaultParams()
+	taxRate := DefaultGenesisState().GenesisTaxRate
+	rewardWeight := DefaultGenesisState().GenesisRewardWeight
+
+	// Constant tax proceeds and seigniorage, one unit of each per bonded luna
+	numEpochs := params.WindowProbation.Int64() + 2
+	epochs := []SimulationEpoch{}
+	for i := int64(0); i < numEpochs; i++ {
+		epochs = append(epochs, NewSimulationEpoch(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.OneDec()))
+	}
+
+	policies, err := SimulatePolicy(params, taxRate, rewardWeight, epochs)
+	require.Nil(t, err)
+	require.Equal(t, int(numEpochs)+1, len(policies))
+
+	// Policies are untouched during the probation period
+	for i := int64(0); i < params.WindowProbation.Int64(); i++ {
+		require.Equal(t, sdk.NewInt(i), policies[i].Epoch)
+		require.Equal(t, taxRate, policies[i].TaxRate)
+		require.Equal(t, rewardWeight, policies[i].RewardWeight)
+	}
+
+	// Flat tax lift; the tax rate grows by the mining increment
+	expectedTaxRate := params.TaxPolicy.Clamp(taxRate, taxRate.Mul(params.MiningIncrement))
+
+	// Seigniorage burden is below 5%, far below the target; the reward weight rises as fast as allowed
+	firstUpdate := policies[params.WindowProbation.Int64()]
+	require.Equal(t, expectedTaxRate, firstUpdate.TaxRate)
+	require.Equal(t, rewardWeight.Add(params.RewardPolicy.ChangeRateMax), firstUpdate.RewardWeight)
+
+	// The next epoch keeps moving in the same direction
+	nextEpoch := policies[numEpochs]
+	require.Equal(t, sdk.NewInt(numEpochs), nextEpoch.Epoch)
+	require.True(t, nextEpoch.TaxRate.GT(policies[numEpochs-1].TaxRate))
+	require.True(t, nextEpoch.RewardWeight.GT(policies[numEpochs-1].RewardWeight))
+}
+
+func TestSimulatePolicyInvalidInput(t *testing.T) {
+	params := DefaultParams()
+	taxRate := DefaultGenesisState().GenesisTaxRate
+	rewardWeight := DefaultGenesisState().GenesisRewardWeight
+
+	epoch := NewSimulationEpoch(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.OneDec())
+
+	// Zero bonded luna
+	noBonded := epoch
+	noBonded.BondedLuna = sdk.ZeroInt()
+	_, err := SimulatePolicy(params, taxRate, rewardWeight, []SimulationEpoch{epoch, noBonded})
+	require.NotNil(t, err)
+
+	// Negative tax proceeds
+	negativeTax := epoch
+	negativeTax.TaxProceeds = sdk.NewInt(-1)
+	_, err = SimulatePolicy(params, taxRate, rewardWeight, []SimulationEpoch{negativeTax})
+	require.NotNil(t, err)
+
+	// Invalid params
+	invalidParams := params
+	invalidParams.TaxPolicy.RateMin = sdk.NewDecWithPrec(-1, 3)
+	_, err = SimulatePolicy(invalidParams, taxRate, rewardWeight, []SimulationEpoch{epoch})
+	require.NotNil(t, err)
+}
+
+func TestSimulatePolicyPIDController(t *testing.T) {
+	params := DefaultParams()
+	params.TaxController = NewControllerParams(ControllerPID, sdk.NewDecWithPrec(1, 3), sdk.ZeroDec(), sdk.ZeroDec(), 1)
+	params.RewardController = NewControllerParams(ControllerPID, sdk.NewDecWithPrec(1, 2), sdk.ZeroDec(), sdk.ZeroDec(), 1)
+	params.HistoryRetention = params.WindowLong.AddRaw(1)
+	taxRate := DefaultGenesisState().GenesisTaxRate
+	rewardWeight := DefaultGenesisState().GenesisRewardWeight
+
+	numEpochs := params.WindowProbation.Int64() + 1
+	epochs := []SimulationEpoch{}
+	for i := int64(0); i < numEpochs; i++ {
+		epochs = append(epochs, NewSimulationEpoch(sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.NewInt(1000000), sdk.OneDec()))
+	}
+
+	policies, err := SimulatePolicy(params, taxRate, rewardWeight, epochs)
+	require.Nil(t, err)
+
+	// Flat tax lift, short of the mining increment
+	taxObservations := []PolicyObservation{NewPolicyObservation(params.MiningIncrement, sdk.OneDec())}
+	expectedTaxRate := params.TaxPolicy.Clamp(taxRate,
+		NewController(params.TaxController).NextRate(taxRate, params.TaxPolicy, taxObservations))
+
+	// Seigniorage rewards of 5% of the seigniorage, out of mining rewards of 1 + 5%
+	rewardObservations := []PolicyObservation{NewPolicyObservation(params.SeigniorageBurdenTarget,
+		seigniorageBurden(rewardWeight, rewardWeight.Add(sdk.OneDec())))}
+	expectedRewardWeight := params.RewardPolicy.Clamp(rewardWeight,
+		NewController(params.RewardController).NextRate(rewardWeight, params.RewardPolicy, rewardObservations))
+
+	firstUpdate := policies[params.WindowProbation.Int64()]
+	require.Equal(t, expectedTaxRate, firstUpdate.TaxRate)
+	require.Equal(t, expectedRewardWeight, firstUpdate.RewardWeight)
+
+	// Gentler than the multiplicative controller on the same data
+	require.True(t, firstUpdate.TaxRate.GT(taxRate))
+	require.True(t, firstUpdate.TaxRate.LT(taxRate.Mul(params.MiningIncrement)))
+	require.True(t, firstUpdate.RewardWeight.GT(rewardWeight))
+	require.True(t, firstUpdate.RewardWeight.LT(rewardWeight.Add(params.RewardPolicy.ChangeRateMax)))
+}
@@ -0,0 +1,83 @@
+package treasury
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyObservationRelativeError(t *testing.T) {
+	// Short of the target
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), NewPolicyObservation(sdk.NewDec(4), sdk.NewDec(3)).RelativeError())
+
+	// Over the target
+	require.Equal(t, sdk.NewDecWithPrec(-25, 2), NewPolicyObservation(sdk.NewDec(3), sdk.NewDec(4)).RelativeError())
+
+	// Bounded by 1 without measurement, and by -1 without setpoint
+	require.Equal(t, sdk.OneDec(), NewPolicyObservation(sdk.NewDec(3), sdk.ZeroDec()).RelativeError())
+	require.Equal(t, sdk.OneDec().Neg(), NewPolicyObservation(sdk.ZeroDec(), sdk.NewDec(3)).RelativeError())
+	require.Equal(t, sdk.ZeroDec(), NewPolicyObservation(sdk.ZeroDec(), sdk.ZeroDec()).RelativeError())
+}
+
+func TestMultiplicativeController(t *testing.T) {
+	constraints := DefaultParams().TaxPolicy
+	controller := NewController(DefaultControllerParams())
+	require.Equal(t, MultiplicativeController{}, controller)
+	require.Equal(t, int64(1), controller.Lookback())
+
+	oldRate := sdk.NewDecWithPrec(1, 3)
+
+	// Scaled by setpoint / measurement; older observations are ignored
+	observations := []PolicyObservation{
+		NewPolicyObservation(sdk.NewDec(3), sdk.NewDec(2)),
+		NewPolicyObservation(sdk.NewDec(100), sdk.NewDec(1)),
+	}
+	require.Equal(t, sdk.NewDecWithPrec(15, 4), controller.NextRate(oldRate, constraints, observations))
+
+	observations = []PolicyObservation{NewPolicyObservation(sdk.NewDec(2), sdk.NewDec(4))}
+	require.Equal(t, sdk.NewDecWithPrec(5, 4), controller.NextRate(oldRate, constraints, observations))
+
+	// No revenues, hike as much as possible
+	observations = []PolicyObservation{NewPolicyObservation(sdk.NewDec(2), sdk.ZeroDec())}
+	require.Equal(t, constraints.RateMax, controller.NextRate(oldRate, constraints, observations))
+}
+
+func TestPIDController(t *testing.T) {
+	constraints := DefaultParams().TaxPolicy
+	params := NewControllerParams(ControllerPID, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(1, 4), sdk.NewDecWithPrec(1, 2), 3)
+	controller := NewController(params)
+	require.Equal(t, NewPIDController(params.Kp, params.Ki, params.Kd, params.Window), controller)
+	require.Equal(t, int64(3), controller.Lookback())
+
+	// The derivative term always reads the previous epoch
+	require.Equal(t, int64(2), NewPIDController(params.Kp, params.Ki, params.Kd, 1).Lookback())
+
+	oldRate := sdk.NewDecWithPrec(1, 3)
+
+	// Relative errors of 0.5, 0.25, -0.5 and 1; the last one is out of the integral window
+	observations := []PolicyObservation{
+		NewPolicyObservation(sdk.NewDec(4), sdk.NewDec(2)),
+		NewPolicyObservation(sdk.NewDec(4), sdk.NewDec(3)),
+		NewPolicyObservation(sdk.NewDec(2), sdk.NewDec(4)),
+		NewPolicyObservation(sdk.NewDec(4), sdk.ZeroDec()),
+	}
+
+	// 0.001 + 0.001 * 0.5 + 0.0001 * 0.25 + 0.01 * 0.25
+	require.Equal(t, sdk.NewDecWithPrec(4025, 6), controller.NextRate(oldRate, constraints, observations))
+
+	// First epoch; no derivative term
+	// 0.001 + 0.001 * 0.5 + 0.0001 * 0.5
+	require.Equal(t, sdk.NewDecWithPrec(155, 5), controller.NextRate(oldRate, constraints, observations[:1]))
+
+	// On target; the integral term keeps pushing, the derivative term damps
+	observations = []PolicyObservation{
+		NewPolicyObservation(sdk.NewDec(4), sdk.NewDec(4)),
+		NewPolicyObservation(sdk.NewDec(4), sdk.NewDec(2)),
+	}
+	// 0.001 + 0 + 0.0001 * 0.5 + 0.01 * -0.5
+	require.Equal(t, sdk.NewDecWithPrec(-395, 5), controller.NextRate(oldRate, constraints, observations))
+
+	// Clamp still applies on the controller output
+	require.Equal(t, constraints.RateMin, constraints.Clamp(constraints.RateMin, controller.NextRate(oldRate, constraints, observations)))
+}
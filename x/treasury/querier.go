@@ -1,11 +1,10 @@
 package treasury
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
-	"github.com/terra-project/core/types/util"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,16 +13,42 @@ import (
 
 // query endpoints supported by the treasury Querier
 const (
-	QueryTaxRate             = "tax-rate"
-	QueryTaxCap              = "tax-cap"
-	QueryMiningRewardWeight  = "reward-weight"
-	QuerySeigniorageProceeds = "seigniorage-proceeds"
-	QueryCurrentEpoch        = "current-epoch"
-	QueryParams              = "params"
-	QueryIssuance            = "issuance"
-	QueryTaxProceeds         = "tax-proceeds"
+	QueryTaxRate               = "tax-rate"
+	QueryTaxCap                = "tax-cap"
+	QueryMiningRewardWeight    = "reward-weight"
+	QuerySeigniorageProceeds   = "seigniorage-proceeds"
+	QuerySeigniorageAllocation = "seigniorage-allocation"
+	QueryCurrentEpoch          = "current-epoch"
+	QueryParams                = "params"
+	QueryIssuance              = "issuance"
+	QueryTaxProceeds           = "tax-proceeds"
+	QueryTaxExemptions         = "tax-exemptions"
+	QueryTaxExemption          = "tax-exemption"
+	QueryTaxEstimate           = "tax-estimate"
+	QueryIndicator             = "indicator"
+	QueryIndicatorSeries       = "indicator-series"
+	QueryNextPolicy            = "next-policy"
+)
+
+// indicators supported by the indicator queries
+const (
+	IndicatorTRL = "trl"
+	IndicatorSRL = "srl"
+	IndicatorMRL = "mrl"
+
+	IndicatorSeigniorageRewards = "seigniorage-rewards"
+	IndicatorMiningRewards      = "mining-rewards"
 )
 
+var indicatorFunctions = map[string]func(sdk.Context, Keeper, sdk.Int) sdk.Dec{
+	IndicatorTRL: TRL,
+	IndicatorSRL: SRL,
+	IndicatorMRL: MRL,
+
+	IndicatorSeigniorageRewards: SeigniorageRewardsForEpoch,
+	IndicatorMiningRewards:      MiningRewardForEpoch,
+}
+
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
@@ -38,8 +63,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTaxProceeds(ctx, path[1:], req, keeper)
 		case QuerySeigniorageProceeds:
 			return querySeigniorageProceeds(ctx, path[1:], req, keeper)
+		case QuerySeigniorageAllocation:
+			return querySeigniorageAllocation(ctx, path[1:], req, keeper)
 		case QueryIssuance:
 			return queryIssuance(ctx, path[1:], req, keeper)
+		case QueryTaxExemptions:
+			return queryTaxExemptions(ctx, req, keeper)
+		case QueryTaxExemption:
+			return queryTaxExemption(ctx, path[1:], req, keeper)
+		case QueryTaxEstimate:
+			return queryTaxEstimate(ctx, req, keeper)
+		case QueryIndicator:
+			return queryIndicator(ctx, path[1:], req, keeper)
+		case QueryIndicatorSeries:
+			return queryIndicatorSeries(ctx, path[1:], req, keeper)
+		case QueryNextPolicy:
+			return queryNextPolicy(ctx, req, keeper)
 		case QueryCurrentEpoch:
 			return queryCurrentEpoch(ctx, req, keeper)
 		case QueryParams:
@@ -67,7 +106,17 @@ func queryTaxRate(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 		return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
 	}
 
-	taxRate := keeper.GetTaxRate(ctx, epoch)
+	if err := keeper.ValidateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	var taxRate sdk.Dec
+	if len(path) > 1 {
+		taxRate = keeper.GetDenomTaxRate(ctx, path[1], epoch)
+	} else {
+		taxRate = keeper.GetTaxRate(ctx, epoch)
+	}
+
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxRateResponse{TaxRate: taxRate})
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -88,7 +137,25 @@ func (r QueryTaxCapResponse) String() (out string) {
 // nolint: unparam
 func queryTaxCap(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	denom := path[0]
-	taxCap := keeper.GetTaxCap(ctx, denom)
+
+	epoch := keeper.ck.GetEpoch(ctx)
+	if len(path) > 1 {
+		var ok bool
+		epoch, ok = sdk.NewIntFromString(path[1])
+		if !ok {
+			return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
+		}
+	}
+
+	if curEpoch := keeper.ck.GetEpoch(ctx); epoch.GT(curEpoch) {
+		return nil, ErrInvalidEpoch(DefaultCodespace, fmt.Sprintf("epoch %s is after the current epoch %s", epoch, curEpoch))
+	}
+
+	if err := keeper.ValidateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	taxCap := keeper.GetTaxCap(ctx, denom, epoch)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxCapResponse{TaxCap: taxCap})
 	if err != nil {
@@ -117,11 +184,15 @@ func queryIssuance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 
 	var day int64
 	if len(dayStr) == 0 {
-		day = ctx.BlockHeight() / util.BlocksPerDay
+		day = keeper.ck.GetDay(ctx).Int64()
 	} else {
 		day, _ = strconv.ParseInt(dayStr, 10, 64)
 	}
 
+	if err := keeper.mtk.ValidateIssuanceDay(ctx, sdk.NewInt(day)); err != nil {
+		return nil, err
+	}
+
 	issuance := keeper.mtk.GetIssuance(ctx, denom, sdk.NewInt(day))
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryIssuanceResponse{Issuance: issuance})
 	if err != nil {
@@ -147,6 +218,10 @@ func queryMiningRewardWeight(ctx sdk.Context, path []string, req abci.RequestQue
 		return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
 	}
 
+	if err := keeper.ValidateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+
 	rewardWeight := keeper.GetRewardWeight(ctx, epoch)
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryMiningRewardWeightResponse{RewardWeight: rewardWeight})
 	if err != nil {
@@ -172,6 +247,10 @@ func queryTaxProceeds(ctx sdk.Context, path []string, req abci.RequestQuery, kee
 		return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
 	}
 
+	if err := keeper.ValidateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+
 	pool := keeper.PeekTaxProceeds(ctx, epoch)
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxProceedsResponse{TaxProceeds: pool})
 	if err != nil {
@@ -197,7 +276,11 @@ func querySeigniorageProceeds(ctx sdk.Context, path []string, req abci.RequestQu
 		return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
 	}
 
-	pool := keeper.mtk.PeekEpochSeigniorage(ctx, epoch)
+	if err := keeper.mtk.ValidateEpochSeigniorage(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	pool := keeper.GetEpochSeigniorage(ctx, epoch)
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QuerySeigniorageProceedsResponse{SeigniorageProceeds: pool})
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -205,6 +288,293 @@ func querySeigniorageProceeds(ctx sdk.Context, path []string, req abci.RequestQu
 	return bz, nil
 }
 
+// nolint: unparam
+func querySeigniorageAllocation(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	epoch, ok := sdk.NewIntFromString(path[0])
+	if !ok {
+		return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
+	}
+
+	if err := keeper.ValidateEpoch(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	allocation := keeper.GetSeigniorageAllocation(ctx, epoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, allocation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QueryTaxExemptionsResponse struct {
+	TaxExemptions TaxExemptions `json:"tax_exemptions"`
+}
+
+func (r QueryTaxExemptionsResponse) String() (out string) {
+	out = r.TaxExemptions.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryTaxExemptions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	exemptions := TaxExemptions{}
+	keeper.IterateTaxExemptions(ctx, func(exemption TaxExemption) (stop bool) {
+		exemptions = append(exemptions, exemption)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxExemptionsResponse{TaxExemptions: exemptions})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QueryTaxExemptionResponse struct {
+	TaxExemption TaxExemption   `json:"tax_exemption"`
+	Votes        ExemptionVotes `json:"votes"`
+}
+
+func (r QueryTaxExemptionResponse) String() (out string) {
+	out = r.TaxExemption.String() + "\n" + r.Votes.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryTaxExemption(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	exemptionID, parseErr := strconv.ParseUint(path[0], 10, 64)
+	if parseErr != nil {
+		return nil, sdk.ErrInternal("exemption id parameter is not correctly formatted")
+	}
+
+	exemption, pErr := keeper.GetTaxExemption(ctx, exemptionID)
+	if pErr != nil {
+		return nil, pErr
+	}
+
+	votes := ExemptionVotes{}
+	keeper.IterateExemptionVotes(ctx, exemptionID, func(voter sdk.AccAddress, option bool) (stop bool) {
+		votes = append(votes, NewExemptionVote(exemptionID, option, voter))
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxExemptionResponse{TaxExemption: exemption, Votes: votes})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// QueryTaxEstimateParams for query 'custom/treasury/tax-estimate'
+type QueryTaxEstimateParams struct {
+	Principal sdk.Coins
+}
+
+// NewQueryTaxEstimateParams creates a new instance of QueryTaxEstimateParams
+func NewQueryTaxEstimateParams(principal sdk.Coins) QueryTaxEstimateParams {
+	return QueryTaxEstimateParams{
+		Principal: principal,
+	}
+}
+
+// JSON response format
+type QueryTaxEstimateResponse struct {
+	TaxEstimate sdk.Coins `json:"tax_estimate"`
+}
+
+func (r QueryTaxEstimateResponse) String() (out string) {
+	out = r.TaxEstimate.String()
+	return strings.TrimSpace(out)
+}
+
+func queryTaxEstimate(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryTaxEstimateParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if !params.Principal.IsValid() {
+		return nil, sdk.ErrInvalidCoins(params.Principal.String())
+	}
+
+	taxes := keeper.EstimateTax(ctx, params.Principal)
+	if taxes == nil {
+		taxes = sdk.Coins{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryTaxEstimateResponse{TaxEstimate: taxes})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// getIndicatorFunction returns the indicator function registered under {indicator}
+func getIndicatorFunction(indicator string) (func(sdk.Context, Keeper, sdk.Int) sdk.Dec, sdk.Error) {
+	indicatorFunction, ok := indicatorFunctions[indicator]
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown indicator %s; supported indicators are %s, %s and %s",
+			indicator, IndicatorTRL, IndicatorSRL, IndicatorMRL))
+	}
+
+	return indicatorFunction, nil
+}
+
+// validateIndicatorEpoch returns an error if the indicators of {epoch} cannot be evaluated
+func validateIndicatorEpoch(ctx sdk.Context, keeper Keeper, epoch sdk.Int) sdk.Error {
+	if curEpoch := keeper.ck.GetEpoch(ctx); epoch.IsNegative() || epoch.GT(curEpoch) {
+		return ErrInvalidEpoch(DefaultCodespace, fmt.Sprintf("epoch %s must be between 0 and the current epoch %s", epoch, curEpoch))
+	}
+
+	return keeper.ValidateEpoch(ctx, epoch)
+}
+
+// JSON response format
+type QueryIndicatorResponse struct {
+	Indicator IndicatorValue `json:"indicator"`
+}
+
+func (r QueryIndicatorResponse) String() (out string) {
+	out = r.Indicator.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryIndicator(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("indicator query takes an indicator and an optional epoch")
+	}
+
+	indicatorFunction, pErr := getIndicatorFunction(path[0])
+	if pErr != nil {
+		return nil, pErr
+	}
+
+	epoch := keeper.ck.GetEpoch(ctx)
+	if len(path) > 1 {
+		var ok bool
+		epoch, ok = sdk.NewIntFromString(path[1])
+		if !ok {
+			return nil, sdk.ErrInternal("epoch parameter is not correctly formatted")
+		}
+	}
+
+	if err := validateIndicatorEpoch(ctx, keeper, epoch); err != nil {
+		return nil, err
+	}
+
+	indicator := EvaluateIndicator(ctx, keeper, epoch, indicatorFunction)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryIndicatorResponse{Indicator: indicator})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QueryIndicatorSeriesResponse struct {
+	Indicators IndicatorValues `json:"indicators"`
+}
+
+func (r QueryIndicatorSeriesResponse) String() (out string) {
+	out = r.Indicators.String()
+	return strings.TrimSpace(out)
+}
+
+// nolint: unparam
+func queryIndicatorSeries(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) != 3 {
+		return nil, sdk.ErrUnknownRequest("indicator series query takes an indicator, a start epoch and an end epoch")
+	}
+
+	indicatorFunction, pErr := getIndicatorFunction(path[0])
+	if pErr != nil {
+		return nil, pErr
+	}
+
+	startEpoch, ok := sdk.NewIntFromString(path[1])
+	if !ok {
+		return nil, sdk.ErrInternal("start epoch parameter is not correctly formatted")
+	}
+
+	endEpoch, ok := sdk.NewIntFromString(path[2])
+	if !ok {
+		return nil, sdk.ErrInternal("end epoch parameter is not correctly formatted")
+	}
+
+	if startEpoch.GT(endEpoch) {
+		return nil, ErrInvalidEpoch(DefaultCodespace, fmt.Sprintf("start epoch %s is after end epoch %s", startEpoch, endEpoch))
+	}
+
+	// A series spans at most the retention window, or WindowLong when history is never pruned
+	params := keeper.GetParams(ctx)
+	maxEpochs := params.HistoryRetention
+	if maxEpochs.IsZero() {
+		maxEpochs = params.WindowLong
+	}
+
+	if endEpoch.Sub(startEpoch).GTE(maxEpochs) {
+		return nil, ErrInvalidEpoch(DefaultCodespace, fmt.Sprintf("epoch range should be shorter than %s epochs", maxEpochs))
+	}
+
+	for _, epoch := range []sdk.Int{startEpoch, endEpoch} {
+		if err := validateIndicatorEpoch(ctx, keeper, epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	indicators := IndicatorValues{}
+	for epoch := startEpoch; epoch.LTE(endEpoch); epoch = epoch.Add(sdk.OneInt()) {
+		indicators = append(indicators, EvaluateIndicator(ctx, keeper, epoch, indicatorFunction))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryIndicatorSeriesResponse{Indicators: indicators})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// JSON response format
+type QueryNextPolicyResponse struct {
+	Epoch         sdk.Int             `json:"epoch"`     // epoch the projected policy applies to
+	Probation     bool                `json:"probation"` // the policy is not updated while in the probation period
+	TaxRate       TaxRateUpdate       `json:"tax_rate"`
+	DenomTaxRates []EpochDenomTaxRate `json:"denom_tax_rates"` // projected tax rates of the denoms listed in TaxRateDenoms
+	RewardWeight  RewardWeightUpdate  `json:"reward_weight"`
+}
+
+func (r QueryNextPolicyResponse) String() (out string) {
+	out = fmt.Sprintf("Epoch: %s\nProbation: %v\n%s\n", r.Epoch, r.Probation, r.TaxRate)
+	for _, denomTaxRate := range r.DenomTaxRates {
+		out += fmt.Sprintf("%s\n", denomTaxRate)
+	}
+	out += r.RewardWeight.String()
+	return strings.TrimSpace(out)
+}
+
+func queryNextPolicy(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	probation, taxRate, denomTaxRates, rewardWeight := projectPolicy(ctx, keeper)
+
+	response := QueryNextPolicyResponse{
+		Epoch:         keeper.ck.GetEpoch(ctx).Add(sdk.OneInt()),
+		Probation:     probation,
+		TaxRate:       taxRate,
+		DenomTaxRates: denomTaxRates,
+		RewardWeight:  rewardWeight,
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, response)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // JSON response format
 type QueryCurrentEpochResponse struct {
 	CurrentEpoch sdk.Int `json:"current_epoch"`
@@ -216,7 +586,7 @@ func (r QueryCurrentEpochResponse) String() (out string) {
 }
 
 func queryCurrentEpoch(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	curEpoch := util.GetEpoch(ctx)
+	curEpoch := keeper.ck.GetEpoch(ctx)
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, QueryCurrentEpochResponse{CurrentEpoch: curEpoch})
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -101,6 +101,23 @@ func getQueriedSeigniorageProceeds(t *testing.T, ctx sdk.Context, cdc *codec.Cod
 	return sdk.NewCoin(assets.MicroLunaDenom, response.SeigniorageProceeds)
 }
 
+func getQueriedSeigniorageAllocation(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, epoch sdk.Int) SeigniorageAllocation {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QuerySeigniorageAllocation}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{QuerySeigniorageAllocation, epoch.String()}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var allocation SeigniorageAllocation
+	err2 := cdc.UnmarshalJSON(bz, &allocation)
+	require.Nil(t, err2)
+
+	return allocation
+}
+
 func getQueriedIssuance(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, denom string) sdk.Int {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, QuerierRoute, QueryIssuance}, "/"),
@@ -175,7 +192,7 @@ func TestQueryRewardWeight(t *testing.T) {
 	rewardWeight := sdk.NewDecWithPrec(77, 2)
 	input.treasuryKeeper.SetRewardWeight(input.ctx, rewardWeight)
 
-	queriedRewardWeight := getQueriedRewardWeight(t, input.ctx, input.cdc, querier, util.GetEpoch(input.ctx))
+	queriedRewardWeight := getQueriedRewardWeight(t, input.ctx, input.cdc, querier, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, queriedRewardWeight, rewardWeight)
 }
@@ -187,11 +204,40 @@ func TestQueryTaxRate(t *testing.T) {
 	taxRate := sdk.NewDecWithPrec(1, 3)
 	input.treasuryKeeper.SetTaxRate(input.ctx, taxRate)
 
-	queriedTaxRate := getQueriedTaxRate(t, input.ctx, input.cdc, querier, util.GetEpoch(input.ctx))
+	queriedTaxRate := getQueriedTaxRate(t, input.ctx, input.cdc, querier, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, queriedTaxRate, taxRate)
 }
 
+func TestQueryDenomTaxRate(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.TaxRateDenoms = []string{assets.MicroKRWDenom}
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	taxRate := sdk.NewDecWithPrec(1, 3)
+	input.treasuryKeeper.SetTaxRate(input.ctx, taxRate)
+	krwTaxRate := sdk.NewDecWithPrec(2, 3)
+	input.treasuryKeeper.setDenomTaxRate(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx), krwTaxRate)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTaxRate}, "/"),
+		Data: []byte{},
+	}
+
+	expected := map[string]sdk.Dec{assets.MicroKRWDenom: krwTaxRate, assets.MicroSDRDenom: taxRate}
+	for denom, rate := range expected {
+		bz, err := querier(input.ctx, []string{QueryTaxRate, input.calendarKeeper.GetEpoch(input.ctx).String(), denom}, query)
+		require.Nil(t, err)
+
+		var response QueryTaxRateResponse
+		input.cdc.MustUnmarshalJSON(bz, &response)
+		require.Equal(t, rate, response.TaxRate)
+	}
+}
+
 func TestQueryTaxCap(t *testing.T) {
 	input := createTestInput(t)
 	querier := NewQuerier(input.treasuryKeeper)
@@ -204,11 +250,40 @@ func TestQueryTaxCap(t *testing.T) {
 	require.Equal(t, queriedTaxCap, params.TaxPolicy.Cap.Amount)
 }
 
+func TestQueryPastTaxCap(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	input.treasuryKeeper.setTaxCap(input.ctx, assets.MicroKRWDenom, sdk.ZeroInt(), sdk.NewInt(1000))
+	input.treasuryKeeper.setTaxCap(input.ctx, assets.MicroKRWDenom, sdk.NewInt(2), sdk.NewInt(2000))
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch * 3)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTaxCap}, "/"),
+		Data: []byte{},
+	}
+
+	// Epochs without a recomputed cap fall back to the latest earlier one
+	expected := map[string]sdk.Int{"0": sdk.NewInt(1000), "1": sdk.NewInt(1000), "2": sdk.NewInt(2000), "3": sdk.NewInt(2000)}
+	for epoch, taxCap := range expected {
+		bz, err := querier(input.ctx, []string{QueryTaxCap, assets.MicroKRWDenom, epoch}, query)
+		require.Nil(t, err)
+
+		var response QueryTaxCapResponse
+		input.cdc.MustUnmarshalJSON(bz, &response)
+		require.Equal(t, taxCap, response.TaxCap)
+	}
+
+	// Future epochs are rejected
+	_, err := querier(input.ctx, []string{QueryTaxCap, assets.MicroKRWDenom, "4"}, query)
+	require.NotNil(t, err)
+}
+
 func TestQueryCurrentEpoch(t *testing.T) {
 	input := createTestInput(t)
 	querier := NewQuerier(input.treasuryKeeper)
 
-	curEpoch := util.GetEpoch(input.ctx)
+	curEpoch := input.calendarKeeper.GetEpoch(input.ctx)
 
 	queriedCurEpoch := getQueriedCurrentEpoch(t, input.ctx, input.cdc, querier)
 
@@ -224,7 +299,7 @@ func TestQueryTaxProceeds(t *testing.T) {
 	}
 	input.treasuryKeeper.RecordTaxProceeds(input.ctx, taxProceeds)
 
-	queriedTaxProceeds := getQueriedTaxProceeds(t, input.ctx, input.cdc, querier, util.GetEpoch(input.ctx))
+	queriedTaxProceeds := getQueriedTaxProceeds(t, input.ctx, input.cdc, querier, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, queriedTaxProceeds, taxProceeds)
 }
@@ -236,16 +311,29 @@ func TestQuerySeigniorageProceeds(t *testing.T) {
 	seigniorageProceeds := sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt(10).MulRaw(assets.MicroUnit))
 	input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, seigniorageProceeds.Amount))
 
-	getQueriedSeigniorageProceeds(t, input.ctx, input.cdc, querier, util.GetEpoch(input.ctx))
+	getQueriedSeigniorageProceeds(t, input.ctx, input.cdc, querier, input.calendarKeeper.GetEpoch(input.ctx))
 
 	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch)
 	input.mintKeeper.Burn(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, seigniorageProceeds.Amount))
 
-	queriedSeigniorageProceeds := getQueriedSeigniorageProceeds(t, input.ctx, input.cdc, querier, util.GetEpoch(input.ctx))
+	queriedSeigniorageProceeds := getQueriedSeigniorageProceeds(t, input.ctx, input.cdc, querier, input.calendarKeeper.GetEpoch(input.ctx))
 
 	require.Equal(t, seigniorageProceeds, queriedSeigniorageProceeds)
 }
 
+func TestQuerySeigniorageAllocation(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	epoch := input.calendarKeeper.GetEpoch(input.ctx)
+	allocation := NewSeigniorageAllocation(epoch, sdk.NewInt(50), sdk.NewInt(100), sdk.NewInt(800), sdk.NewInt(40), sdk.NewInt(10))
+	input.treasuryKeeper.SetSeigniorageAllocation(input.ctx, allocation)
+
+	queriedAllocation := getQueriedSeigniorageAllocation(t, input.ctx, input.cdc, querier, epoch)
+
+	require.Equal(t, allocation, queriedAllocation)
+}
+
 func TestQueryIssuance(t *testing.T) {
 	input := createTestInput(t)
 	querier := NewQuerier(input.treasuryKeeper)
@@ -258,3 +346,227 @@ func TestQueryIssuance(t *testing.T) {
 
 	require.Equal(t, issuance, queriedIssuance)
 }
+
+func TestQueryPrunedEpoch(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	input.ctx = input.ctx.WithBlockHeight(util.BlocksPerEpoch * 10)
+	input.treasuryKeeper.PruneHistory(input.ctx, sdk.NewInt(5))
+
+	for _, route := range []string{QueryTaxRate, QueryMiningRewardWeight, QueryTaxProceeds, QuerySeigniorageAllocation} {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, QuerierRoute, route}, "/"),
+			Data: []byte{},
+		}
+
+		_, err := querier(input.ctx, []string{route, "4"}, query)
+		require.NotNil(t, err)
+		require.Equal(t, CodeHistoryPruned, err.Code())
+
+		_, err = querier(input.ctx, []string{route, "5"}, query)
+		require.Nil(t, err)
+	}
+}
+
+func TestQueryTaxExemptions(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	exemption := NewTaxExemption(input.treasuryKeeper.NewExemptionID(input.ctx), "test", "testdescription",
+		[]sdk.AccAddress{addrs[0], addrs[1]}, addrs[0], 0, 10)
+	input.treasuryKeeper.StoreTaxExemption(input.ctx, exemption)
+	input.treasuryKeeper.AddExemptionVote(input.ctx, exemption.ExemptionID, addrs[2], true)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTaxExemptions}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(input.ctx, []string{QueryTaxExemptions}, query)
+	require.Nil(t, err)
+
+	var listResponse QueryTaxExemptionsResponse
+	err2 := input.cdc.UnmarshalJSON(bz, &listResponse)
+	require.Nil(t, err2)
+	require.Equal(t, TaxExemptions{exemption}, listResponse.TaxExemptions)
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTaxExemption}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err = querier(input.ctx, []string{QueryTaxExemption, "1"}, query)
+	require.Nil(t, err)
+
+	var response QueryTaxExemptionResponse
+	err2 = input.cdc.UnmarshalJSON(bz, &response)
+	require.Nil(t, err2)
+	require.Equal(t, exemption, response.TaxExemption)
+	require.Equal(t, ExemptionVotes{NewExemptionVote(1, true, addrs[2])}, response.Votes)
+
+	_, err = querier(input.ctx, []string{QueryTaxExemption, "2"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeExemptionNotFound, err.Code())
+}
+
+func TestQueryTaxEstimate(t *testing.T) {
+	input := createTestInput(t)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	input.treasuryKeeper.SetTaxRate(input.ctx, sdk.NewDecWithPrec(1, 2))
+	input.treasuryKeeper.setTaxCap(input.ctx, assets.MicroKRWDenom, input.calendarKeeper.GetEpoch(input.ctx), sdk.NewInt(1000))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryTaxEstimate}, "/"),
+		Data: input.cdc.MustMarshalJSON(NewQueryTaxEstimateParams(sdk.NewCoins(
+			sdk.NewInt64Coin(assets.MicroKRWDenom, 1000000),
+			sdk.NewInt64Coin(assets.MicroSDRDenom, 50000),
+			sdk.NewInt64Coin(assets.MicroLunaDenom, 10),
+		))),
+	}
+
+	bz, err := querier(input.ctx, []string{QueryTaxEstimate}, query)
+	require.Nil(t, err)
+
+	var response QueryTaxEstimateResponse
+	err2 := input.cdc.UnmarshalJSON(bz, &response)
+	require.Nil(t, err2)
+
+	// ukrw is capped, usdr is charged the rate and the uluna tax rounds down to zero
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin(assets.MicroKRWDenom, 1000),
+		sdk.NewInt64Coin(assets.MicroSDRDenom, 500),
+	), response.TaxEstimate)
+
+	query.Data = []byte("invalid")
+	_, err = querier(input.ctx, []string{QueryTaxEstimate}, query)
+	require.NotNil(t, err)
+}
+
+func TestQueryIndicator(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	for epoch := int64(0); epoch < 4; epoch++ {
+		input.ctx = input.ctx.WithBlockHeight(epoch * util.BlocksPerEpoch)
+		input.treasuryKeeper.RecordTaxProceeds(input.ctx, sdk.Coins{sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt((epoch+1)*100))})
+	}
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryIndicator}, "/"),
+		Data: []byte{},
+	}
+
+	// Defaults to the current epoch and matches what the EndBlocker computes
+	bz, err := querier(input.ctx, []string{QueryIndicator, IndicatorTRL}, query)
+	require.Nil(t, err)
+
+	var response QueryIndicatorResponse
+	input.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, sdk.NewInt(3), response.Indicator.Epoch)
+	require.Equal(t, TRL(input.ctx, input.treasuryKeeper, sdk.NewInt(3)), response.Indicator.Value)
+	require.Equal(t, RollingAverageIndicator(input.ctx, input.treasuryKeeper, params.WindowShort, TRL), response.Indicator.ShortAverage)
+	require.Equal(t, RollingAverageIndicator(input.ctx, input.treasuryKeeper, params.WindowLong, TRL), response.Indicator.LongAverage)
+	require.Equal(t, SumIndicator(input.ctx, input.treasuryKeeper, params.WindowShort, TRL), response.Indicator.ShortSum)
+	require.Equal(t, SumIndicator(input.ctx, input.treasuryKeeper, params.WindowLong, TRL), response.Indicator.LongSum)
+
+	// The reward sums the reward weight update compares are exposed
+	bz, err = querier(input.ctx, []string{QueryIndicator, IndicatorMiningRewards}, query)
+	require.Nil(t, err)
+
+	input.cdc.MustUnmarshalJSON(bz, &response)
+	require.Equal(t, MiningRewardForEpoch(input.ctx, input.treasuryKeeper, sdk.NewInt(3)), response.Indicator.Value)
+	require.Equal(t, SumIndicator(input.ctx, input.treasuryKeeper, params.WindowShort, MiningRewardForEpoch), response.Indicator.ShortSum)
+	require.Equal(t, SumIndicator(input.ctx, input.treasuryKeeper, params.WindowLong, MiningRewardForEpoch), response.Indicator.LongSum)
+
+	// Future epochs and unknown indicators are rejected
+	_, err = querier(input.ctx, []string{QueryIndicator, IndicatorTRL, "4"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidEpoch, err.Code())
+
+	_, err = querier(input.ctx, []string{QueryIndicator, "unknown"}, query)
+	require.NotNil(t, err)
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryIndicatorSeries}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err = querier(input.ctx, []string{QueryIndicatorSeries, IndicatorMRL, "1", "3"}, query)
+	require.Nil(t, err)
+
+	var seriesResponse QueryIndicatorSeriesResponse
+	input.cdc.MustUnmarshalJSON(bz, &seriesResponse)
+	require.Equal(t, 3, len(seriesResponse.Indicators))
+	for i, indicator := range seriesResponse.Indicators {
+		epoch := sdk.NewInt(int64(i + 1))
+		require.Equal(t, EvaluateIndicator(input.ctx, input.treasuryKeeper, epoch, MRL), indicator)
+	}
+
+	_, err = querier(input.ctx, []string{QueryIndicatorSeries, IndicatorMRL, "3", "1"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidEpoch, err.Code())
+
+	// Ranges longer than the retention window are rejected
+	params.HistoryRetention = sdk.NewInt(2)
+	input.treasuryKeeper.SetParams(input.ctx, params)
+	_, err = querier(input.ctx, []string{QueryIndicatorSeries, IndicatorMRL, "1", "3"}, query)
+	require.NotNil(t, err)
+	require.Equal(t, CodeInvalidEpoch, err.Code())
+}
+
+func TestQueryNextPolicy(t *testing.T) {
+	input := createTestInput(t)
+	input = reset(input)
+	querier := NewQuerier(input.treasuryKeeper)
+
+	params := input.treasuryKeeper.GetParams(input.ctx)
+	params.TaxRateDenoms = []string{assets.MicroKRWDenom}
+	input.treasuryKeeper.SetParams(input.ctx, params)
+
+	lastEpoch := params.WindowProbation.Int64()
+	for epoch := int64(0); epoch <= lastEpoch; epoch++ {
+		input.ctx = input.ctx.WithBlockHeight(epoch * util.BlocksPerEpoch)
+		input.treasuryKeeper.RecordTaxProceeds(input.ctx, sdk.NewCoins(
+			sdk.NewCoin(assets.MicroSDRDenom, sdk.NewInt((epoch%3+1)*100)),
+			sdk.NewCoin(assets.MicroKRWDenom, sdk.NewInt((epoch%2+1)*100)),
+		))
+		input.mintKeeper.Mint(input.ctx, addrs[0], sdk.NewCoin(assets.MicroLunaDenom, sdk.NewInt((epoch%2+1)*1000)))
+	}
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QueryNextPolicy}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(input.ctx, []string{QueryNextPolicy}, query)
+	require.Nil(t, err)
+
+	var response QueryNextPolicyResponse
+	input.cdc.MustUnmarshalJSON(bz, &response)
+
+	nextEpoch := sdk.NewInt(lastEpoch + 1)
+	require.Equal(t, nextEpoch, response.Epoch)
+	require.False(t, response.Probation)
+	require.Equal(t, params.TaxPolicy.Clamp(response.TaxRate.OldTaxRate, response.TaxRate.RawTaxRate), response.TaxRate.NewTaxRate)
+	require.Equal(t, params.RewardPolicy.Clamp(response.RewardWeight.OldRewardWeight, response.RewardWeight.RawRewardWeight),
+		response.RewardWeight.NewRewardWeight)
+
+	// The dry run does not touch the store
+	store := input.ctx.KVStore(input.treasuryKeeper.key)
+	require.Nil(t, store.Get(keyTaxRate(nextEpoch)))
+	require.Nil(t, store.Get(keyDenomTaxRate(assets.MicroKRWDenom, nextEpoch)))
+	require.Nil(t, store.Get(keyRewardWeight(nextEpoch)))
+
+	// The projection matches the update of the EndBlocker
+	input.ctx = input.ctx.WithBlockHeight(nextEpoch.Int64()*util.BlocksPerEpoch - 1)
+	EndBlocker(input.ctx, input.treasuryKeeper)
+	require.Equal(t, response.TaxRate.NewTaxRate, input.treasuryKeeper.GetTaxRate(input.ctx, nextEpoch))
+	require.Equal(t, []EpochDenomTaxRate{
+		NewEpochDenomTaxRate(nextEpoch, assets.MicroKRWDenom, input.treasuryKeeper.GetDenomTaxRate(input.ctx, assets.MicroKRWDenom, nextEpoch)),
+	}, response.DenomTaxRates)
+	require.Equal(t, response.RewardWeight.NewRewardWeight, input.treasuryKeeper.GetRewardWeight(input.ctx, nextEpoch))
+}
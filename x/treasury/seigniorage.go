@@ -0,0 +1,84 @@
+package treasury
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SeigniorageAllocation - struct to record where the seigniorage of an epoch was allocated, in micro Luna
+type SeigniorageAllocation struct {
+	Epoch          sdk.Int `json:"epoch"`           // Epoch whose seigniorage was allocated
+	MinerRewards   sdk.Int `json:"miner_rewards"`   // Seigniorage burned as mining rewards, at the reward weight of the epoch
+	OracleRewards  sdk.Int `json:"oracle_rewards"`  // Seigniorage added to the oracle swap fee pool
+	BudgetPrograms sdk.Int `json:"budget_programs"` // Seigniorage paid to the budget programs with outstanding claims
+	CommunityPool  sdk.Int `json:"community_pool"`  // Seigniorage added to the distribution community pool
+	Burned         sdk.Int `json:"burned"`          // Seigniorage burned outright
+}
+
+// NewSeigniorageAllocation creates a SeigniorageAllocation instance
+func NewSeigniorageAllocation(epoch, minerRewards, oracleRewards, budgetPrograms, communityPool, burned sdk.Int) SeigniorageAllocation {
+	return SeigniorageAllocation{
+		Epoch:          epoch,
+		MinerRewards:   minerRewards,
+		OracleRewards:  oracleRewards,
+		BudgetPrograms: budgetPrograms,
+		CommunityPool:  communityPool,
+		Burned:         burned,
+	}
+}
+
+// String implements fmt.Stringer
+func (a SeigniorageAllocation) String() string {
+	return fmt.Sprintf(`SeigniorageAllocation
+	Epoch:          %s
+	MinerRewards:   %s
+	OracleRewards:  %s
+	BudgetPrograms: %s
+	CommunityPool:  %s
+	Burned:         %s`,
+		a.Epoch, a.MinerRewards, a.OracleRewards, a.BudgetPrograms, a.CommunityPool, a.Burned)
+}
+
+// Total returns the seigniorage of the epoch, whatever it was allocated to
+func (a SeigniorageAllocation) Total() sdk.Int {
+	return a.MinerRewards.Add(a.OracleRewards).Add(a.BudgetPrograms).Add(a.CommunityPool).Add(a.Burned)
+}
+
+// SettleBudgetPrograms returns the allocation with {paid} recorded as the seigniorage paid to budget
+// programs. The budget share that could not be paid is burned instead.
+func (a SeigniorageAllocation) SettleBudgetPrograms(paid sdk.Int) SeigniorageAllocation {
+	if paid.GT(a.BudgetPrograms) {
+		paid = a.BudgetPrograms
+	}
+
+	a.Burned = a.Burned.Add(a.BudgetPrograms.Sub(paid))
+	a.BudgetPrograms = paid
+	return a
+}
+
+// SeigniorageAllocations is a collection of SeigniorageAllocation
+type SeigniorageAllocations []SeigniorageAllocation
+
+func (l SeigniorageAllocations) String() (out string) {
+	for _, val := range l {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// splitSeigniorage divides the seigniorage of an epoch. The reward weight share is burned as mining
+// rewards; the rest is divided between the oracle, budget programs, the community pool and the burn
+// according to the shares in params. Rounding dust is burned.
+func splitSeigniorage(epoch, seigniorage sdk.Int, rewardWeight sdk.Dec, params Params) SeigniorageAllocation {
+	minerRewards := rewardWeight.MulInt(seigniorage).TruncateInt()
+	pool := seigniorage.Sub(minerRewards)
+
+	oracleRewards := params.OracleSeigniorageShare.MulInt(pool).TruncateInt()
+	budgetPrograms := params.BudgetSeigniorageShare.MulInt(pool).TruncateInt()
+	communityPool := params.CommunityPoolSeigniorageShare.MulInt(pool).TruncateInt()
+	burned := pool.Sub(oracleRewards).Sub(budgetPrograms).Sub(communityPool)
+
+	return NewSeigniorageAllocation(epoch, minerRewards, oracleRewards, budgetPrograms, communityPool, burned)
+}
@@ -9,13 +9,27 @@ var (
 	ActionSettle       = "settle"
 	ActionPolicyUpdate = "policy-update"
 
-	Action      = sdk.TagAction
-	Denom       = "denom"
-	Amount      = "amount"
-	Rewardee    = "rewardee"
-	Tax         = "tax"
-	Class       = "class"
-	MinerReward = "miner-weight"
-	Oracle      = "oracle-reward"
-	Budget      = "budget"
+	ActionSeigniorageAllocation = "seigniorage-allocation"
+
+	ActionExemptionPassed   = "tax-exemption-passed"
+	ActionExemptionRejected = "tax-exemption-rejected"
+	ActionExemptionRemoved  = "tax-exemption-removed"
+
+	Action       = sdk.TagAction
+	Denom        = "denom"
+	Amount       = "amount"
+	Rewardee     = "rewardee"
+	Tax          = "tax"
+	DenomTax     = "denom-tax"
+	Class        = "class"
+	MinerReward  = "miner-weight"
+	Oracle       = "oracle-reward"
+	Budget       = "budget"
+	MinerRewards = "miner-rewards"
+	Community    = "community-pool"
+	Burned       = "burned"
+	ExemptionID  = "exemption-id"
+	Voter        = "voter"
+	Option       = "option"
+	Weight       = "weight"
 )
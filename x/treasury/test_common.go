@@ -2,6 +2,7 @@ package treasury
 
 import (
 	"github.com/terra-project/core/types/assets"
+	"github.com/terra-project/core/x/calendar"
 	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/mint"
 	"github.com/terra-project/core/x/oracle"
@@ -64,6 +65,8 @@ type testInput struct {
 	mintKeeper     mint.Keeper
 	treasuryKeeper Keeper
 	distrKeeper    distr.Keeper
+	calendarKeeper calendar.Keeper
+	feeKeeper      auth.FeeCollectionKeeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -82,6 +85,7 @@ func createTestInput(t *testing.T) testInput {
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
+	keyCalendar := sdk.NewKVStoreKey(calendar.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(oracle.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
@@ -101,6 +105,7 @@ func createTestInput(t *testing.T) testInput {
 	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyCalendar, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
@@ -141,18 +146,24 @@ func createTestInput(t *testing.T) testInput {
 		cdc, keyDistr, paramsKeeper.Subspace(distr.DefaultParamspace),
 		bankKeeper, &stakingKeeper, feeCollectionKeeper, distr.DefaultCodespace,
 	)
+	distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
 
 	stakingKeeper.SetPool(ctx, staking.InitialPool())
 	stakingParams := staking.DefaultParams()
 	stakingParams.BondDenom = assets.MicroLunaDenom
 	stakingKeeper.SetParams(ctx, stakingParams)
 
+	calendarKeeper := calendar.NewKeeper(cdc, keyCalendar, paramsKeeper.Subspace(calendar.DefaultParamspace))
+	calendar.InitGenesis(ctx, calendarKeeper, calendar.DefaultGenesisState())
+
 	mintKeeper := mint.NewKeeper(
 		cdc,
 		keyMint,
 		stakingKeeper,
 		bankKeeper,
 		accKeeper,
+		calendarKeeper,
+		paramsKeeper.Subspace(mint.DefaultParamspace),
 	)
 
 	sh := staking.NewHandler(stakingKeeper)
@@ -186,6 +197,9 @@ func createTestInput(t *testing.T) testInput {
 		keyMarket,
 		oracleKeeper,
 		mintKeeper,
+		bankKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(market.DefaultParamspace))
 
 	marketKeeper.SetParams(ctx, market.DefaultParams())
@@ -196,10 +210,13 @@ func createTestInput(t *testing.T) testInput {
 		stakingKeeper.GetValidatorSet(),
 		mintKeeper,
 		marketKeeper,
+		oracleKeeper,
+		distrKeeper,
+		calendarKeeper,
 		paramsKeeper.Subspace(DefaultParamspace),
 	)
 
 	InitGenesis(ctx, treasuryKeeper, DefaultGenesisState())
 
-	return testInput{ctx, cdc, bankKeeper, oracleKeeper, marketKeeper, mintKeeper, treasuryKeeper, distrKeeper}
+	return testInput{ctx, cdc, bankKeeper, oracleKeeper, marketKeeper, mintKeeper, treasuryKeeper, distrKeeper, calendarKeeper, feeCollectionKeeper}
 }